@@ -0,0 +1,68 @@
+package tripletex
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrAccountInactive is returned when validating an inactive account.
+	ErrAccountInactive = errors.New("tripletex: account is inactive")
+	// ErrAccountNotPostable is returned when validating an account that
+	// doesn't allow direct postings, like customer and vendor ledger accounts.
+	ErrAccountNotPostable = errors.New("tripletex: account doesn't allow direct postings")
+)
+
+// AccountService groups the ledger account lookups.
+//
+// Use [TripletexClient.Accounts] to get one.
+type AccountService struct {
+	client *TripletexClient
+}
+
+// Accounts returns an [AccountService] using c.
+func (c *TripletexClient) Accounts() *AccountService {
+	return &AccountService{client: c}
+}
+
+// ByNumber returns the ledger account with number, eg. 3000, from the
+// [RefData] cache.
+//
+// Returns [ErrNotFound] if no account has number.
+func (s *AccountService) ByNumber(ctx context.Context, number int32) (*Account, error) {
+	return s.client.RefData().AccountByNumber(ctx, number)
+}
+
+// ForPosting returns the ledger account with number, if it's valid for
+// posting according to [ValidatePostingAccount].
+func (s *AccountService) ForPosting(ctx context.Context, number int32) (*Account, error) {
+	account, err := s.ByNumber(ctx, number)
+	if err != nil {
+		return nil, err
+	}
+	if err = ValidatePostingAccount(*account); err != nil {
+		return nil, err
+	}
+	return account, nil
+}
+
+// ValidatePostingAccount returns [ErrAccountInactive] if account is inactive,
+// and [ErrAccountNotPostable] if it belongs to a customer, vendor or employee
+// sub-ledger, which only accept postings through invoices and payments.
+func ValidatePostingAccount(account Account) error {
+	number := int32(0)
+	if account.Number != nil {
+		number = *account.Number
+	}
+	if account.IsInactive != nil && *account.IsInactive {
+		return fmt.Errorf("%w: %d", ErrAccountInactive, number)
+	}
+	if account.LedgerType != nil {
+		switch *account.LedgerType {
+		case AccountLedgerTypeCUSTOMER, AccountLedgerTypeVENDOR, AccountLedgerTypeEMPLOYEE:
+			return fmt.Errorf("%w: %d is a %s ledger account", ErrAccountNotPostable, number, *account.LedgerType)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+package tripletex
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccountForPosting(t *testing.T) {
+	customerLedger := AccountLedgerTypeCUSTOMER
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /ledger/account", func(w http.ResponseWriter, r *http.Request) {
+		writeTestJSON(w, http.StatusOK, ListResponseAccount{Values: &[]Account{
+			{Id: ptr(int64(1)), Number: ptr(int32(3000))},
+			{Id: ptr(int64(2)), Number: ptr(int32(3001)), IsInactive: ptr(true)},
+			{Id: ptr(int64(3)), Number: ptr(int32(1500)), LedgerType: &customerLedger},
+		}})
+	})
+	c := newTestClient(t, mux)
+
+	tests := []struct {
+		description string
+		number      int32
+		expectedErr error
+	}{
+		{description: "active general account", number: 3000},
+		{description: "inactive account", number: 3001, expectedErr: ErrAccountInactive},
+		{description: "customer ledger account", number: 1500, expectedErr: ErrAccountNotPostable},
+		{description: "unknown account", number: 9999, expectedErr: ErrNotFound},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			require := require.New(t)
+
+			account, err := c.Accounts().ForPosting(context.Background(), test.number)
+			if test.expectedErr != nil {
+				require.ErrorIs(err, test.expectedErr)
+				return
+			}
+			require.NoError(err)
+			require.Equal(test.number, *account.Number)
+		})
+	}
+}
@@ -0,0 +1,14 @@
+package tripletex
+
+// TripletexAPI is the interface of the generated endpoint methods of
+// [TripletexClient].
+//
+// Depend on it instead of [*TripletexClient] in code that should be unit
+// tested with a fake or a mock, eg. [tripletexmock.MockTripletexAPI].
+//
+// [tripletexmock.MockTripletexAPI]: https://pkg.go.dev/github.com/valuetechdev/tripletex-go/tripletexmock#MockTripletexAPI
+type TripletexAPI interface {
+	ClientWithResponsesInterface
+}
+
+var _ TripletexAPI = (*TripletexClient)(nil)
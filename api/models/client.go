@@ -0,0 +1,18 @@
+package models
+
+import (
+	"context"
+	"net/http"
+)
+
+// RequestEditorFn is the function signature for the RequestEditor callback
+// function. It's shared by the clients of all API domains, so an editor
+// works with any of them.
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// HttpRequestDoer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
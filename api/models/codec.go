@@ -0,0 +1,29 @@
+package models
+
+import "encoding/json"
+
+// JSONCodec encodes request bodies and decodes response bodies of the domain
+// clients, eg. with github.com/goccy/go-json for faster decoding.
+//
+// The zero value, and nil functions, use encoding/json.
+type JSONCodec struct {
+	MarshalFunc   func(v any) ([]byte, error)
+	UnmarshalFunc func(data []byte, v any) error
+}
+
+// Marshal encodes v with MarshalFunc, or [json.Marshal] if nil.
+func (c JSONCodec) Marshal(v any) ([]byte, error) {
+	if c.MarshalFunc == nil {
+		return json.Marshal(v)
+	}
+	return c.MarshalFunc(v)
+}
+
+// Unmarshal decodes data into v with UnmarshalFunc, or [json.Unmarshal] if
+// nil.
+func (c JSONCodec) Unmarshal(data []byte, v any) error {
+	if c.UnmarshalFunc == nil {
+		return json.Unmarshal(data, v)
+	}
+	return c.UnmarshalFunc(data, v)
+}
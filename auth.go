@@ -2,103 +2,67 @@ package tripletex
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
-	"time"
+
+	"github.com/valuetechdev/tripletex-go/auth"
 )
 
-type Token struct {
-	ExpiresAt   time.Time `json:"expiresAt"`
-	AccessToken string    `json:"token"`
-}
+// Token is a session token, see [auth.Token].
+type Token = auth.Token
 
-// Revalidates [Token].
+// Revalidates [Token]. Must be called with c.tokenMu held.
 //
 // Returns error when failing to make http requests, read/parse response body.
 func (c *TripletexClient) revalidate() error {
 	creds := c.credentials
-	expiresAt := time.Now().Add(c.tokenDuration)
-	req, err := http.NewRequest(http.MethodPut, fmt.Sprintf("%s/token/session/:create", c.baseURL), http.NoBody)
+	token, err := auth.CreateToken(context.Background(), c.httpClient, c.codec, c.baseURL, creds.ConsumerToken, creds.EmployeeToken, c.now().Add(c.tokenDuration))
 	if err != nil {
-		return fmt.Errorf("tripletex: auth: failed to create http request: %w", err)
-	}
-
-	q := req.URL.Query()
-	q.Add("consumerToken", creds.ConsumerToken)
-	q.Add("employeeToken", creds.EmployeeToken)
-	q.Add("expirationDate", expiresAt.Format(time.DateOnly))
-	req.URL.RawQuery = q.Encode()
-
-	res, err := c.httpClient.Do(req)
-	if err != nil {
-		return fmt.Errorf("tripletex: auth: failed to do http request: %w", err)
-	}
-
-	if res.StatusCode != http.StatusOK {
-		return fmt.Errorf("tripletex: auth: status not OK: %s", res.Status)
-	}
-
-	defer res.Body.Close()
-	body, err := io.ReadAll(res.Body)
-	if err != nil {
-		return fmt.Errorf("tripletex: auth: failed to read response body: %w", err)
-	}
-
-	var sessionTokenRes ResponseWrapperSessionToken
-	if err = json.Unmarshal(body, &sessionTokenRes); err != nil {
-		return fmt.Errorf("tripletex: auth: failed to parse response body: %w", err)
-	}
-
-	if sessionTokenRes.Value == nil {
-		return fmt.Errorf("tripletex: auth: session token value body is empty")
-	}
-	sessionToken := *sessionTokenRes.Value
-
-	if sessionTokenRes.Value.ExpirationDate == nil {
-		return fmt.Errorf("tripletex: auth: session token expirationDate is empty")
-	}
-
-	expiresAt, err = time.Parse(time.DateOnly, *sessionToken.ExpirationDate)
-	if err != nil {
-		return fmt.Errorf("authentication: failed to parse expiresAt (%s): %w", *sessionToken.ExpirationDate, err)
-	}
-
-	c.token = &Token{
-		AccessToken: *sessionToken.Token,
-		ExpiresAt:   expiresAt,
+		return err
 	}
-
+	c.token = token
 	return nil
 }
 
 func (c *TripletexClient) GetToken() *Token {
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
 	return c.token
 }
 
 func (c *TripletexClient) SetToken(token *Token) {
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
 	c.token = token
 }
 
-// Returns true if token is valid.
+// Returns true if token is valid, and doesn't expire within the margin set
+// with [WithTokenRefreshMargin].
 func (c *TripletexClient) IsTokenValid() bool {
-	if c.token == nil {
-		return false
-	}
-	return time.Now().Before(c.token.ExpiresAt)
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
+	return c.token.Valid(c.now(), c.refreshMargin)
 }
 
 // Check if auth is valid.
 //
 // Revalidates token if invalid
 func (c *TripletexClient) CheckAuth() error {
-	if !c.IsTokenValid() {
+	_, err := c.validToken()
+	return err
+}
+
+// validToken returns the token, revalidating it if invalid. Concurrent
+// callers wait for a single revalidation.
+func (c *TripletexClient) validToken() (*Token, error) {
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
+	if !c.token.Valid(c.now(), c.refreshMargin) {
 		if err := c.revalidate(); err != nil {
-			return fmt.Errorf("tripletex: auth: failed to revalidate token: %w", err)
+			return nil, fmt.Errorf("tripletex: auth: failed to revalidate token: %w", err)
 		}
 	}
-	return nil
+	return c.token, nil
 }
 
 // Intercepts authentication on [http.Request] r.
@@ -106,16 +70,16 @@ func (c *TripletexClient) CheckAuth() error {
 // Sets the token with basic auth with username 0 (or credentials.EmployeeToken
 // if accountant client) and [Token.AccessToken] as password.
 //
-// Returns error if unable to revalidate token.
+// Returns error if unable to revalidate token. Does nothing for clients
+// created with [WithDryRun].
 func (c *TripletexClient) interceptAuth(ctx context.Context, r *http.Request) error {
-	if err := c.CheckAuth(); err != nil {
-		return err
+	if c.dryRun != nil {
+		return nil
 	}
-	username := "0"
-	if clientId := c.credentials.clientId; clientId != 0 {
-		username = fmt.Sprintf("%d", clientId)
+	token, err := c.validToken()
+	if err != nil {
+		return err
 	}
-	r.SetBasicAuth(username, c.token.AccessToken)
-
+	auth.SetBasicAuth(r, token, c.credentials.clientId)
 	return nil
 }
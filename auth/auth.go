@@ -0,0 +1,194 @@
+// Package auth authenticates requests to the Tripletex API with session
+// tokens.
+//
+// It's shared by [tripletex.TripletexClient] and the domain clients in api/...,
+// which can be used without the tripletex package to link only the domains
+// an application uses:
+//
+//	transport := &auth.Transport{ConsumerToken: "...", EmployeeToken: "..."}
+//	c, err := customer.NewClientWithResponses(auth.DefaultBaseURL, customer.WithHTTPClient(&http.Client{Transport: transport}))
+//
+// [tripletex.TripletexClient]: https://pkg.go.dev/github.com/valuetechdev/tripletex-go#TripletexClient
+package auth
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+	_ "time/tzdata" // Europe/Oslo must be available on systems without tzdata
+
+	"github.com/valuetechdev/tripletex-go/api/models"
+)
+
+// DefaultBaseURL is the base URL of the production API.
+const DefaultBaseURL = "https://tripletex.no/v2"
+
+// Oslo is the time zone of the Tripletex API, which session tokens expire in
+// and dates and times without a zone are in.
+var Oslo = func() *time.Location {
+	loc, err := time.LoadLocation("Europe/Oslo")
+	if err != nil {
+		panic(fmt.Errorf("tripletex: auth: failed to load location: %w", err))
+	}
+	return loc
+}()
+
+// Token is a session token.
+type Token struct {
+	ExpiresAt   time.Time `json:"expiresAt"`
+	AccessToken string    `json:"token"`
+}
+
+// CreateToken creates a session token for the consumer and employee tokens,
+// valid until at least expiresAt. The response is decoded with codec.
+//
+// Session tokens expire at midnight Oslo time at the start of their
+// expiration date, so the expiration date requested is the first one at or
+// after expiresAt, and [Token.ExpiresAt] is midnight Oslo time.
+//
+// Returns error when failing to make http requests, read/parse response body.
+func CreateToken(ctx context.Context, client *http.Client, codec models.JSONCodec, baseURL, consumerToken, employeeToken string, expiresAt time.Time) (*Token, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("%s/token/session/:create", strings.TrimSuffix(baseURL, "/")), http.NoBody)
+	if err != nil {
+		return nil, fmt.Errorf("tripletex: auth: failed to create http request: %w", err)
+	}
+
+	q := req.URL.Query()
+	q.Add("consumerToken", consumerToken)
+	q.Add("employeeToken", employeeToken)
+	q.Add("expirationDate", expirationDate(expiresAt).Format(time.DateOnly))
+	req.URL.RawQuery = q.Encode()
+
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("tripletex: auth: failed to do http request: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("tripletex: auth: status not OK: %s", res.Status)
+	}
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("tripletex: auth: failed to read response body: %w", err)
+	}
+
+	var sessionTokenRes models.ResponseWrapperSessionToken
+	if err = codec.Unmarshal(body, &sessionTokenRes); err != nil {
+		return nil, fmt.Errorf("tripletex: auth: failed to parse response body: %w", err)
+	}
+
+	if sessionTokenRes.Value == nil {
+		return nil, fmt.Errorf("tripletex: auth: session token value body is empty")
+	}
+	sessionToken := *sessionTokenRes.Value
+
+	if sessionToken.ExpirationDate == nil {
+		return nil, fmt.Errorf("tripletex: auth: session token expirationDate is empty")
+	}
+	if sessionToken.Token == nil {
+		return nil, fmt.Errorf("tripletex: auth: session token is empty")
+	}
+
+	expiresAt, err = time.ParseInLocation(time.DateOnly, *sessionToken.ExpirationDate, Oslo)
+	if err != nil {
+		return nil, fmt.Errorf("tripletex: auth: failed to parse expiresAt (%s): %w", *sessionToken.ExpirationDate, err)
+	}
+
+	return &Token{
+		AccessToken: *sessionToken.Token,
+		ExpiresAt:   expiresAt,
+	}, nil
+}
+
+// expirationDate returns the first midnight in Oslo at or after t.
+func expirationDate(t time.Time) time.Time {
+	t = t.In(Oslo)
+	date := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, Oslo)
+	if date.Before(t) {
+		date = date.AddDate(0, 0, 1)
+	}
+	return date
+}
+
+// Valid reports whether token is still valid at now with margin to spare.
+func (t *Token) Valid(now time.Time, margin time.Duration) bool {
+	return t != nil && now.Add(margin).Before(t.ExpiresAt)
+}
+
+// SetBasicAuth sets token as basic auth on r, with username 0, or clientId
+// to act as an accountant client.
+//
+// See https://developer.tripletex.no/docs/documentation/authentication-and-tokens/#accountant-token
+// for more details.
+func SetBasicAuth(r *http.Request, token *Token, clientId int64) {
+	r.SetBasicAuth(fmt.Sprintf("%d", clientId), token.AccessToken)
+}
+
+// Transport is an [http.RoundTripper] authenticating requests with a session
+// token, created on the first request and recreated when it expires.
+type Transport struct {
+	ConsumerToken string            // Application specific token
+	EmployeeToken string            // Client specific token
+	ClientId      int64             // Optional, act as the accountant client with this id
+	BaseURL       string            // Defaults to [DefaultBaseURL]
+	TokenDuration time.Duration     // Defaults to one month
+	RefreshMargin time.Duration     // Optional, recreate the token when less than this remains
+	Base          http.RoundTripper // Defaults to [http.DefaultTransport]
+	Now           func() time.Time  // Defaults to [time.Now]
+	Codec         models.JSONCodec  // Defaults to encoding/json
+
+	mu    sync.Mutex
+	token *Token
+}
+
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	token, err := t.Token(req.Context())
+	if err != nil {
+		return nil, err
+	}
+	req = req.Clone(req.Context())
+	SetBasicAuth(req, token, t.ClientId)
+	return t.base().RoundTrip(req)
+}
+
+// Token returns the session token, creating it if missing or expired.
+func (t *Transport) Token(ctx context.Context) (*Token, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := time.Now
+	if t.Now != nil {
+		now = t.Now
+	}
+	if t.token.Valid(now(), t.RefreshMargin) {
+		return t.token, nil
+	}
+
+	baseURL := t.BaseURL
+	if baseURL == "" {
+		baseURL = DefaultBaseURL
+	}
+	expiresAt := now().AddDate(0, 1, 0)
+	if t.TokenDuration != 0 {
+		expiresAt = now().Add(t.TokenDuration)
+	}
+	token, err := CreateToken(ctx, &http.Client{Transport: t.base()}, t.Codec, baseURL, t.ConsumerToken, t.EmployeeToken, expiresAt)
+	if err != nil {
+		return nil, err
+	}
+	t.token = token
+	return token, nil
+}
+
+func (t *Transport) base() http.RoundTripper {
+	if t.Base != nil {
+		return t.Base
+	}
+	return http.DefaultTransport
+}
@@ -0,0 +1,80 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/valuetechdev/tripletex-go/api/customer"
+	"github.com/valuetechdev/tripletex-go/api/models"
+)
+
+func TestTransport(t *testing.T) {
+	require := require.New(t)
+
+	var created []string
+	mux := http.NewServeMux()
+	mux.HandleFunc("PUT /v2/token/session/:create", func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		require.Equal("consumer", q.Get("consumerToken"))
+		require.Equal("employee", q.Get("employeeToken"))
+		created = append(created, q.Get("expirationDate"))
+		json.NewEncoder(w).Encode(map[string]any{"value": map[string]any{
+			"token":          "session-" + q.Get("expirationDate"),
+			"expirationDate": q.Get("expirationDate"),
+		}})
+	})
+	mux.HandleFunc("GET /v2/customer", func(w http.ResponseWriter, r *http.Request) {
+		username, password, ok := r.BasicAuth()
+		if !ok || password != "session-"+created[len(created)-1] {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]any{"values": []map[string]any{{"id": 1, "name": username}}})
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	now := time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC)
+	transport := &Transport{
+		ConsumerToken: "consumer",
+		EmployeeToken: "employee",
+		ClientId:      42,
+		BaseURL:       server.URL + "/v2",
+		TokenDuration: 24 * time.Hour,
+		Now:           func() time.Time { return now },
+	}
+	c, err := customer.NewClientWithResponses(transport.BaseURL, customer.WithHTTPClient(&http.Client{Transport: transport}))
+	require.NoError(err)
+
+	for range 2 {
+		res, err := c.CustomerSearchWithResponse(context.Background(), &models.CustomerSearchParams{})
+		require.NoError(err)
+		require.Equal(http.StatusOK, res.StatusCode())
+		require.Equal("42", *(*res.JSONDefault.Values)[0].Name)
+	}
+	require.Equal([]string{"2025-03-12"}, created, "token should be reused until it expires")
+
+	now = now.Add(48 * time.Hour)
+	res, err := c.CustomerSearchWithResponse(context.Background(), &models.CustomerSearchParams{})
+	require.NoError(err)
+	require.Equal(http.StatusOK, res.StatusCode())
+	require.Equal([]string{"2025-03-12", "2025-03-14"}, created, "token should be recreated when expired")
+}
+
+func TestCreateTokenError(t *testing.T) {
+	require := require.New(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	t.Cleanup(server.Close)
+
+	_, err := CreateToken(context.Background(), server.Client(), models.JSONCodec{}, server.URL, "consumer", "employee", time.Now())
+	require.ErrorContains(err, "403")
+}
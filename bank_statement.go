@@ -0,0 +1,168 @@
+package tripletex
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"time"
+)
+
+// ErrBankStatementNotProcessed is returned by
+// [TripletexClient.ImportBankStatement] when the imported transactions don't
+// show up within [BankStatementImport.MaxPolls].
+var ErrBankStatementNotProcessed = errors.New("tripletex: bank statement: not processed")
+
+// BankStatementImport configures [TripletexClient.ImportBankStatement].
+type BankStatementImport struct {
+	BankId    int64
+	AccountId int64
+	FromDate  time.Time // Inclusive
+	ToDate    time.Time // Exclusive
+
+	// FileFormat of the uploaded file. The Tripletex API has no dedicated
+	// CAMT.053 value, so use the format Tripletex expects for your bank.
+	FileFormat BankStatementImportImportBankStatementParamsFileFormat
+	FileName   string // Defaults to "statement.xml"
+	ExternalId string // Optional
+
+	// PollInterval is the time between polls for imported transactions.
+	// Defaults to 2 seconds.
+	PollInterval time.Duration
+	// MaxPolls is the maximum number of polls for imported transactions.
+	// Defaults to 10.
+	MaxPolls int
+}
+
+// ImportedBankStatement is the result of [TripletexClient.ImportBankStatement].
+type ImportedBankStatement struct {
+	Statement    BankStatement
+	Transactions []BankTransaction
+}
+
+// ImportBankStatement uploads a bank statement file (eg. CAMT.053) from r, and
+// waits for Tripletex to finish processing it.
+//
+// Processing is considered done when the statement has transactions, and
+// their number is unchanged between two polls. Returns
+// [ErrBankStatementNotProcessed] if that doesn't happen within
+// [BankStatementImport.MaxPolls].
+//
+// Returns error when failing to upload the file, fetch the transactions or if
+// ctx is done.
+func (c *TripletexClient) ImportBankStatement(ctx context.Context, r io.Reader, opts BankStatementImport) (*ImportedBankStatement, error) {
+	if opts.FileName == "" {
+		opts.FileName = "statement.xml"
+	}
+	if opts.PollInterval <= 0 {
+		opts.PollInterval = 2 * time.Second
+	}
+	if opts.MaxPolls <= 0 {
+		opts.MaxPolls = 10
+	}
+
+	body, contentType, err := multipartFile(opts.FileName, r)
+	if err != nil {
+		return nil, fmt.Errorf("tripletex: bank statement: %w", err)
+	}
+
+	params := &BankStatementImportImportBankStatementParams{
+		BankId:     opts.BankId,
+		AccountId:  opts.AccountId,
+		FromDate:   opts.FromDate.Format(time.DateOnly),
+		ToDate:     opts.ToDate.Format(time.DateOnly),
+		FileFormat: opts.FileFormat,
+	}
+	if opts.ExternalId != "" {
+		params.ExternalId = &opts.ExternalId
+	}
+
+	res, err := c.BankStatementImportImportBankStatementWithBodyWithResponse(ctx, params, contentType, body)
+	if err != nil {
+		return nil, fmt.Errorf("tripletex: bank statement: failed to upload: %w", err)
+	}
+	if err = checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return nil, fmt.Errorf("tripletex: bank statement: failed to upload: %w", err)
+	}
+	if res.JSONDefault == nil || res.JSONDefault.Value == nil || res.JSONDefault.Value.Id == nil {
+		return nil, fmt.Errorf("tripletex: bank statement: upload response is empty")
+	}
+
+	statement := *res.JSONDefault.Value
+	transactions, err := c.pollBankStatementTransactions(ctx, *statement.Id, opts.PollInterval, opts.MaxPolls)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ImportedBankStatement{Statement: statement, Transactions: transactions}, nil
+}
+
+// pollBankStatementTransactions fetches the transactions of a bank statement
+// until there are some, and their count is stable between two polls.
+func (c *TripletexClient) pollBankStatementTransactions(ctx context.Context, statementId int64, interval time.Duration, maxPolls int) ([]BankTransaction, error) {
+	previous := 0
+	for i := 0; i < maxPolls; i++ {
+		if i > 0 {
+			select {
+			case <-ctx.Done():
+				return nil, fmt.Errorf("tripletex: bank statement: %w", ctx.Err())
+			case <-time.After(interval):
+			}
+		}
+
+		transactions, err := c.BankStatementTransactions(ctx, statementId)
+		if err != nil {
+			return nil, err
+		}
+		if len(transactions) > 0 && len(transactions) == previous {
+			return transactions, nil
+		}
+		previous = len(transactions)
+	}
+
+	return nil, fmt.Errorf("%w: statement %d after %d polls", ErrBankStatementNotProcessed, statementId, maxPolls)
+}
+
+// BankStatementTransactions returns all transactions of the bank statement
+// with id statementId.
+func (c *TripletexClient) BankStatementTransactions(ctx context.Context, statementId int64) ([]BankTransaction, error) {
+	return collectPages(ctx, 0, func(ctx context.Context, from, count int) ([]BankTransaction, PageInfo, error) {
+		res, err := c.BankStatementTransactionSearchWithResponse(ctx, &BankStatementTransactionSearchParams{
+			BankStatementId: statementId,
+			From:            &from,
+			Count:           &count,
+		})
+		if err != nil {
+			return nil, PageInfo{}, fmt.Errorf("tripletex: bank statement: failed to search transactions: %w", err)
+		}
+		if err = checkResponse(res.HTTPResponse, res.Body); err != nil {
+			return nil, PageInfo{}, fmt.Errorf("tripletex: bank statement: failed to search transactions: %w", err)
+		}
+		if res.JSONDefault == nil {
+			return nil, PageInfo{}, nil
+		}
+		return listValues(res.JSONDefault.Values), res.JSONDefault.PageInfo(), nil
+	})
+}
+
+// multipartFile encodes the content of r as a multipart form with a single
+// "file" field, as expected by the Tripletex upload endpoints.
+//
+// Returns the body and its content type.
+func multipartFile(fileName string, r io.Reader) (*bytes.Buffer, string, error) {
+	var body bytes.Buffer
+	mw := multipart.NewWriter(&body)
+	fw, err := mw.CreateFormFile("file", fileName)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to create form file: %w", err)
+	}
+	if _, err = io.Copy(fw, r); err != nil {
+		return nil, "", fmt.Errorf("failed to read file: %w", err)
+	}
+	if err = mw.Close(); err != nil {
+		return nil, "", fmt.Errorf("failed to close multipart writer: %w", err)
+	}
+	return &body, mw.FormDataContentType(), nil
+}
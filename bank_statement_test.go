@@ -0,0 +1,95 @@
+package tripletex
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestImportBankStatement(t *testing.T) {
+	require := require.New(t)
+
+	var polls atomic.Int32
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /bank/statement/import", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal("DNB_CSV", r.URL.Query().Get("fileFormat"))
+		require.Equal("2025-01-01", r.URL.Query().Get("fromDate"))
+		f, _, err := r.FormFile("file")
+		require.NoError(err)
+		b, err := io.ReadAll(f)
+		require.NoError(err)
+		require.Equal("<Document/>", string(b))
+		writeTestJSON(w, http.StatusCreated, ResponseWrapperBankStatement{Value: &BankStatement{Id: ptr(int64(7))}})
+	})
+	mux.HandleFunc("GET /bank/statement/transaction", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal("7", r.URL.Query().Get("bankStatementId"))
+		var transactions []BankTransaction
+		switch polls.Add(1) {
+		case 1, 2:
+		case 3:
+			transactions = []BankTransaction{{Id: ptr(int64(1))}}
+		default:
+			transactions = []BankTransaction{{Id: ptr(int64(1))}, {Id: ptr(int64(2))}}
+		}
+		writeTestJSON(w, http.StatusOK, ListResponseBankTransaction{Values: &transactions})
+	})
+	c := newTestClient(t, mux)
+
+	res, err := c.ImportBankStatement(context.Background(), strings.NewReader("<Document/>"), BankStatementImport{
+		BankId:       1,
+		AccountId:    2,
+		FromDate:     time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		ToDate:       time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC),
+		FileFormat:   BankStatementImportImportBankStatementParamsFileFormatDNBCSV,
+		PollInterval: time.Millisecond,
+	})
+	require.NoError(err)
+	require.Equal(int64(7), *res.Statement.Id)
+	require.Len(res.Transactions, 2)
+	require.Equal(int32(5), polls.Load(), "should poll until the transaction count is non-zero and stable")
+}
+
+func TestImportBankStatementNotProcessed(t *testing.T) {
+	require := require.New(t)
+
+	var polls atomic.Int32
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /bank/statement/import", func(w http.ResponseWriter, r *http.Request) {
+		writeTestJSON(w, http.StatusCreated, ResponseWrapperBankStatement{Value: &BankStatement{Id: ptr(int64(7))}})
+	})
+	mux.HandleFunc("GET /bank/statement/transaction", func(w http.ResponseWriter, r *http.Request) {
+		polls.Add(1)
+		writeTestJSON(w, http.StatusOK, ListResponseBankTransaction{Values: &[]BankTransaction{}})
+	})
+	c := newTestClient(t, mux)
+
+	_, err := c.ImportBankStatement(context.Background(), strings.NewReader("<Document/>"), BankStatementImport{
+		PollInterval: time.Millisecond,
+		MaxPolls:     3,
+	})
+	require.ErrorIs(err, ErrBankStatementNotProcessed)
+	require.Equal(int32(3), polls.Load())
+}
+
+func TestImportBankStatementAPIError(t *testing.T) {
+	require := require.New(t)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /bank/statement/import", func(w http.ResponseWriter, r *http.Request) {
+		writeTestJSON(w, http.StatusUnprocessableEntity, APIError{Status: 422, Code: 15000, Message: "Invalid file", RequestId: "abc"})
+	})
+	c := newTestClient(t, mux)
+
+	_, err := c.ImportBankStatement(context.Background(), strings.NewReader(""), BankStatementImport{})
+	require.Error(err)
+	var apiErr *APIError
+	require.ErrorAs(err, &apiErr)
+	require.Equal(15000, apiErr.Code)
+	require.Equal("abc", apiErr.RequestId)
+}
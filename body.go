@@ -0,0 +1,30 @@
+package tripletex
+
+import "reflect"
+
+// DiscardBody drops the raw body of res, a generated *Response, if it has a
+// 2xx status, keeping only the decoded values. Bodies of other statuses are
+// kept for diagnostics, eg. for the [APIError] of the response.
+//
+// Useful in high-throughput syncs where responses are retained, as the raw
+// body otherwise doubles the memory of each response. Wrap the call:
+//
+//	res, err := tripletex.DiscardBody(c.CustomerSearchWithResponse(ctx, params))
+func DiscardBody[R interface{ StatusCode() int }](res R, err error) (R, error) {
+	if err != nil {
+		return res, err
+	}
+	if code := res.StatusCode(); code < 200 || code >= 300 {
+		return res, nil
+	}
+
+	v := reflect.ValueOf(res)
+	if v.Kind() != reflect.Pointer || v.IsNil() {
+		return res, nil
+	}
+	body := v.Elem().FieldByName("Body")
+	if body.IsValid() && body.CanSet() && body.Type() == reflect.TypeFor[[]byte]() {
+		body.SetBytes(nil)
+	}
+	return res, nil
+}
@@ -0,0 +1,52 @@
+package tripletex
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiscardBody(t *testing.T) {
+	tests := []struct {
+		description string
+		status      int
+		wantBody    bool
+	}{
+		{description: "ok", status: http.StatusOK, wantBody: false},
+		{description: "error", status: http.StatusNotFound, wantBody: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			require := require.New(t)
+
+			mux := http.NewServeMux()
+			mux.HandleFunc("GET /customer/1", func(w http.ResponseWriter, r *http.Request) {
+				if tt.status != http.StatusOK {
+					writeTestJSON(w, tt.status, APIError{Status: tt.status, Message: "Object not found"})
+					return
+				}
+				writeTestJSON(w, tt.status, ResponseWrapperCustomer{Value: &Customer{Id: ptr(int64(1)), Name: ptr("Acme")}})
+			})
+			c := newTestClient(t, mux)
+
+			res, err := DiscardBody(c.CustomerGetWithResponse(context.Background(), 1, &CustomerGetParams{}))
+			require.NoError(err)
+			require.Equal(tt.wantBody, res.Body != nil)
+			if tt.status == http.StatusOK {
+				require.Equal("Acme", *res.JSONDefault.Value.Name)
+			} else {
+				require.ErrorContains(checkResponse(res.HTTPResponse, res.Body), "Object not found")
+			}
+		})
+	}
+}
+
+func TestDiscardBodyError(t *testing.T) {
+	errFailed := errors.New("failed")
+	res, err := DiscardBody[*CustomerGetResponse](nil, errFailed)
+	require.ErrorIs(t, err, errFailed)
+	require.Nil(t, res)
+}
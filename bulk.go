@@ -0,0 +1,200 @@
+package tripletex
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+)
+
+// BulkItem is the outcome of a single item in a bulk operation.
+type BulkItem[T any] struct {
+	Index int   // Index of the item in the input
+	Input T     // Item as sent
+	Value *T    // Item as returned by Tripletex, nil if failed
+	Err   error // Error for this item, nil if succeeded
+}
+
+// BulkResult is the outcome of a bulk operation, in input order.
+type BulkResult[T any] struct {
+	Items []BulkItem[T]
+}
+
+// Succeeded returns the items that succeeded.
+func (r *BulkResult[T]) Succeeded() []BulkItem[T] {
+	var items []BulkItem[T]
+	for _, item := range r.Items {
+		if item.Err == nil {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// Failed returns the items that failed.
+func (r *BulkResult[T]) Failed() []BulkItem[T] {
+	var items []BulkItem[T]
+	for _, item := range r.Items {
+		if item.Err != nil {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// Err returns the errors of all failed items joined, or nil if all
+// succeeded.
+func (r *BulkResult[T]) Err() error {
+	var errs []error
+	for _, item := range r.Failed() {
+		errs = append(errs, item.Err)
+	}
+	return errors.Join(errs...)
+}
+
+type bulkConfig struct {
+	batchSize   int
+	concurrency int
+	bisect      bool
+}
+
+// BulkOption configures bulk operations.
+type BulkOption func(*bulkConfig)
+
+// WithBulkBatchSize sets the number of items sent per request. Defaults to 100.
+func WithBulkBatchSize(size int) BulkOption {
+	return func(cfg *bulkConfig) {
+		cfg.batchSize = size
+	}
+}
+
+// WithBulkConcurrency sets the number of requests in flight at the same time.
+// Defaults to 4.
+func WithBulkConcurrency(concurrency int) BulkOption {
+	return func(cfg *bulkConfig) {
+		cfg.concurrency = concurrency
+	}
+}
+
+// WithoutBulkBisection makes a batch rejected by Tripletex fail all of its
+// items.
+//
+// By default, a rejected batch is split in halves and sent again,
+// recursively, until the items causing the rejection are isolated. Each failed
+// item then gets the error of its own single-item request, and the other
+// items of the batch succeed. Only rejections that can be caused by the items
+// themselves are bisected, ie. 4xx responses other than 401, 403 and 429.
+func WithoutBulkBisection() BulkOption {
+	return func(cfg *bulkConfig) {
+		cfg.bisect = false
+	}
+}
+
+func newBulkConfig(options []BulkOption) bulkConfig {
+	cfg := bulkConfig{batchSize: 100, concurrency: 4, bisect: true}
+	for _, option := range options {
+		option(&cfg)
+	}
+	if cfg.batchSize <= 0 {
+		cfg.batchSize = 100
+	}
+	if cfg.concurrency <= 0 {
+		cfg.concurrency = 1
+	}
+	return cfg
+}
+
+// bulkSendFunc sends a batch to a /list endpoint and returns the values from
+// the response, in the same order as batch.
+type bulkSendFunc[T any] func(ctx context.Context, batch []T) ([]T, error)
+
+// BulkExecute sends inputs in batches using send, eg. a function calling a
+// /list endpoint, and returns the outcome of each input.
+//
+// send must return the values from the response in the same order as the
+// batch. A rejected batch is bisected to isolate the items causing the
+// rejection, see [WithoutBulkBisection].
+func BulkExecute[T any](ctx context.Context, inputs []T, send func(ctx context.Context, batch []T) ([]T, error), options ...BulkOption) *BulkResult[T] {
+	cfg := newBulkConfig(options)
+
+	result := &BulkResult[T]{Items: make([]BulkItem[T], len(inputs))}
+	indexes := make([]int, len(inputs))
+	for i, input := range inputs {
+		result.Items[i] = BulkItem[T]{Index: i, Input: input}
+		indexes[i] = i
+	}
+
+	runBulk(ctx, cfg, result.Items, indexes, send)
+	return result
+}
+
+// runBulk sends the items at indexes in batches using send, with at most
+// cfg.concurrency batches in flight, and records the outcome in items.
+//
+// A failed batch marks all of its items as failed, unless cfg.bisect is set.
+func runBulk[T any](ctx context.Context, cfg bulkConfig, items []BulkItem[T], indexes []int, send bulkSendFunc[T]) {
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, cfg.concurrency)
+	for start := 0; start < len(indexes); start += cfg.batchSize {
+		batchIndexes := indexes[start:min(start+cfg.batchSize, len(indexes))]
+
+		select {
+		case <-ctx.Done():
+			for _, i := range batchIndexes {
+				items[i].Err = ctx.Err()
+			}
+			continue
+		case sem <- struct{}{}:
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			sendBatch(ctx, cfg, items, batchIndexes, send)
+		}()
+	}
+	wg.Wait()
+}
+
+// sendBatch sends the items at indexes as a single batch and records the
+// outcome in items, bisecting the batch if it's rejected and cfg.bisect is
+// set.
+func sendBatch[T any](ctx context.Context, cfg bulkConfig, items []BulkItem[T], indexes []int, send bulkSendFunc[T]) {
+	batch := make([]T, len(indexes))
+	for j, i := range indexes {
+		batch[j] = items[i].Input
+	}
+
+	values, err := send(ctx, batch)
+	if err != nil && cfg.bisect && len(indexes) > 1 && isItemError(err) {
+		mid := len(indexes) / 2
+		sendBatch(ctx, cfg, items, indexes[:mid], send)
+		sendBatch(ctx, cfg, items, indexes[mid:], send)
+		return
+	}
+
+	for j, i := range indexes {
+		if err != nil {
+			items[i].Err = err
+			continue
+		}
+		if j < len(values) {
+			items[i].Value = &values[j]
+		}
+	}
+}
+
+// isItemError reports whether err is a rejection that can be caused by the
+// items of a request, rather than eg. authentication or rate limiting.
+func isItemError(err error) bool {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	switch apiErr.Status {
+	case http.StatusUnauthorized, http.StatusForbidden, http.StatusTooManyRequests:
+		return false
+	}
+	return apiErr.Status >= 400 && apiErr.Status < 500
+}
@@ -0,0 +1,112 @@
+package tripletex
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBulkExecute(t *testing.T) {
+	rejectBad := func(mu *sync.Mutex, requests *int, err error) func(ctx context.Context, batch []string) ([]string, error) {
+		return func(ctx context.Context, batch []string) ([]string, error) {
+			mu.Lock()
+			*requests++
+			mu.Unlock()
+			if slices.Contains(batch, "bad") {
+				return nil, err
+			}
+			return batch, nil
+		}
+	}
+	inputs := []string{"a", "b", "bad", "c", "d", "bad", "e", "f"}
+
+	tests := []struct {
+		description  string
+		err          error
+		options      []BulkOption
+		wantFailed   []int
+		wantRequests int
+	}{
+		{
+			description:  "without bisection",
+			err:          &APIError{Status: http.StatusUnprocessableEntity},
+			options:      []BulkOption{WithBulkBatchSize(4), WithoutBulkBisection()},
+			wantFailed:   []int{0, 1, 2, 3, 4, 5, 6, 7},
+			wantRequests: 2,
+		},
+		{
+			description:  "with bisection",
+			err:          &APIError{Status: http.StatusUnprocessableEntity},
+			options:      []BulkOption{WithBulkBatchSize(4)},
+			wantFailed:   []int{2, 5},
+			wantRequests: 10,
+		},
+		{
+			description:  "not bisecting rate limits",
+			err:          &APIError{Status: http.StatusTooManyRequests},
+			options:      []BulkOption{WithBulkBatchSize(4)},
+			wantFailed:   []int{0, 1, 2, 3, 4, 5, 6, 7},
+			wantRequests: 2,
+		},
+		{
+			description:  "not bisecting transport errors",
+			err:          errors.New("connection reset"),
+			options:      []BulkOption{WithBulkBatchSize(4)},
+			wantFailed:   []int{0, 1, 2, 3, 4, 5, 6, 7},
+			wantRequests: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			require := require.New(t)
+
+			var mu sync.Mutex
+			var requests int
+			result := BulkExecute(context.Background(), inputs, rejectBad(&mu, &requests, tt.err), tt.options...)
+
+			var failed []int
+			for _, item := range result.Failed() {
+				require.ErrorIs(item.Err, tt.err)
+				failed = append(failed, item.Index)
+			}
+			require.Equal(tt.wantFailed, failed)
+			require.Equal(tt.wantRequests, requests)
+			for _, item := range result.Succeeded() {
+				require.Equal(item.Input, *item.Value)
+			}
+		})
+	}
+}
+
+func TestBulkExecuteDefaults(t *testing.T) {
+	require := require.New(t)
+
+	inputs := make([]int, 250)
+	for i := range inputs {
+		inputs[i] = i
+	}
+	var mu sync.Mutex
+	var batches [][]int
+	result := BulkExecute(context.Background(), inputs, func(ctx context.Context, batch []int) ([]int, error) {
+		mu.Lock()
+		batches = append(batches, batch)
+		mu.Unlock()
+		if slices.Contains(batch, 142) {
+			return nil, &APIError{Status: http.StatusUnprocessableEntity, Message: "Validation failed"}
+		}
+		return batch, nil
+	})
+
+	failed := result.Failed()
+	require.Len(failed, 1)
+	require.Equal(142, failed[0].Index)
+	require.Len(result.Succeeded(), 249)
+	for _, batch := range batches {
+		require.LessOrEqual(len(batch), 100)
+	}
+}
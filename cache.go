@@ -0,0 +1,210 @@
+package tripletex
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// WithResponseCache caches successful GET responses of slow-changing
+// endpoints, eg. accounts, VAT types, company info and employees, for the TTL
+// of the longest path prefix in ttls matching the request:
+//
+//	tripletex.WithResponseCache(map[string]time.Duration{
+//		"/ledger/account": time.Hour,
+//		"/ledger/vatType": 24 * time.Hour,
+//		"/employee":       10 * time.Minute,
+//	})
+//
+// Responses are cached by URL, including the fields and other query
+// parameters, and the accountant client acted as. Expired responses with an
+// ETag or Last-Modified header are revalidated with a conditional request.
+// Successful requests of other methods drop the cached responses under the
+// same prefix.
+func WithResponseCache(ttls map[string]time.Duration) Option {
+	return func(tc *TripletexClient) {
+		tc.cacheTTLs = ttls
+	}
+}
+
+// maxCacheEntries is the number of responses kept by [cacheTransport].
+// Expired responses are evicted when reached, and then an arbitrary one if
+// none has expired.
+const maxCacheEntries = 1024
+
+// cachedResponse is a response kept by [cacheTransport]. It's replaced
+// rather than changed, so it can be read without holding the lock.
+type cachedResponse struct {
+	prefix    string
+	status    int
+	header    http.Header
+	body      []byte
+	expiresAt time.Time
+}
+
+// cacheTransport caches GET responses of next, see [WithResponseCache].
+type cacheTransport struct {
+	next    http.RoundTripper
+	ttls    map[string]time.Duration
+	baseURL string
+	now     func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]*cachedResponse
+}
+
+// withCacheTransport returns a copy of client caching responses.
+func withCacheTransport(client *http.Client, ttls map[string]time.Duration, baseURL string, now func() time.Time) *http.Client {
+	next := client.Transport
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	c := *client
+	c.Transport = &cacheTransport{next: next, ttls: ttls, baseURL: baseURL, now: now, entries: make(map[string]*cachedResponse)}
+	return &c
+}
+
+func (t *cacheTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	prefix, ttl, ok := t.rule(req)
+	if !ok {
+		return t.next.RoundTrip(req)
+	}
+	if req.Method != http.MethodGet {
+		res, err := t.next.RoundTrip(req)
+		if err == nil && res.StatusCode >= 200 && res.StatusCode < 300 {
+			t.invalidate(prefix)
+		}
+		return res, err
+	}
+	if req.Header.Get("If-None-Match") != "" || req.Header.Get("If-Modified-Since") != "" {
+		return t.next.RoundTrip(req) // Conditional requests of the caller are theirs to handle
+	}
+
+	key := cacheKey(req)
+	t.mu.Lock()
+	entry := t.entries[key]
+	t.mu.Unlock()
+	if entry != nil && t.now().Before(entry.expiresAt) {
+		return entry.response(req), nil
+	}
+	if entry != nil && entry.header.Get("ETag") == "" && entry.header.Get("Last-Modified") == "" {
+		entry = nil // Can't be revalidated
+	}
+
+	if entry != nil {
+		req = req.Clone(req.Context())
+		if etag := entry.header.Get("ETag"); etag != "" {
+			req.Header.Set("If-None-Match", etag)
+		}
+		if lastModified := entry.header.Get("Last-Modified"); lastModified != "" {
+			req.Header.Set("If-Modified-Since", lastModified)
+		}
+	}
+
+	res, err := t.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode == http.StatusNotModified && entry != nil {
+		res.Body.Close()
+		renewed := *entry
+		renewed.expiresAt = t.now().Add(ttl)
+		t.store(key, &renewed)
+		return renewed.response(req), nil
+	}
+	if res.StatusCode != http.StatusOK {
+		return res, nil
+	}
+
+	body, err := io.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	res.Body = io.NopCloser(bytes.NewReader(body))
+
+	t.store(key, &cachedResponse{
+		prefix:    prefix,
+		status:    res.StatusCode,
+		header:    res.Header.Clone(),
+		body:      body,
+		expiresAt: t.now().Add(ttl),
+	})
+	return res, nil
+}
+
+// store caches entry under key, evicting entries if the cache is full.
+func (t *cacheTransport) store(key string, entry *cachedResponse) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if _, ok := t.entries[key]; !ok && len(t.entries) >= maxCacheEntries {
+		now := t.now()
+		for k, e := range t.entries {
+			if !now.Before(e.expiresAt) {
+				delete(t.entries, k)
+			}
+		}
+		for k := range t.entries {
+			if len(t.entries) < maxCacheEntries {
+				break
+			}
+			delete(t.entries, k)
+		}
+	}
+	t.entries[key] = entry
+}
+
+// rule returns the longest prefix of the path of req relative to the base
+// URL in t.ttls, and its TTL.
+func (t *cacheTransport) rule(req *http.Request) (string, time.Duration, bool) {
+	path := req.URL.Path
+	if base, err := req.URL.Parse(t.baseURL); err == nil {
+		path = strings.TrimPrefix(path, strings.TrimSuffix(base.Path, "/"))
+	}
+
+	var prefix string
+	var ttl time.Duration
+	for p, d := range t.ttls {
+		if len(p) > len(prefix) && (path == p || strings.HasPrefix(path, strings.TrimSuffix(p, "/")+"/")) {
+			prefix, ttl = p, d
+		}
+	}
+	return prefix, ttl, prefix != ""
+}
+
+// invalidate drops the cached responses under prefix.
+func (t *cacheTransport) invalidate(prefix string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for key, entry := range t.entries {
+		if entry.prefix == prefix {
+			delete(t.entries, key)
+		}
+	}
+}
+
+// cacheKey returns the key of req, its URL and the accountant client it acts
+// as, ie. the basic auth username.
+func cacheKey(req *http.Request) string {
+	username, _, _ := req.BasicAuth()
+	return username + " " + req.URL.String()
+}
+
+// response returns a copy of e as a response to req.
+func (e *cachedResponse) response(req *http.Request) *http.Response {
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", e.status, http.StatusText(e.status)),
+		StatusCode:    e.status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        e.header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(e.body)),
+		ContentLength: int64(len(e.body)),
+		Request:       req,
+	}
+}
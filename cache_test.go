@@ -0,0 +1,173 @@
+package tripletex
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCacheTransport(t *testing.T) {
+	tests := []struct {
+		description   string
+		etag          string
+		requests      []string // method and path, sent a minute apart
+		expectedCalls int
+		expectedLast  string
+	}{
+		{
+			description:   "serves fresh response from cache",
+			requests:      []string{"GET /ledger/account?fields=id", "GET /ledger/account?fields=id"},
+			expectedCalls: 1,
+			expectedLast:  "1",
+		},
+		{
+			description:   "keys on fields",
+			requests:      []string{"GET /ledger/account?fields=id", "GET /ledger/account?fields=id,name"},
+			expectedCalls: 2,
+			expectedLast:  "2",
+		},
+		{
+			description:   "refetches expired response",
+			requests:      []string{"GET /ledger/account", "", "", "GET /ledger/account"},
+			expectedCalls: 2,
+			expectedLast:  "2",
+		},
+		{
+			description:   "revalidates expired response with etag",
+			etag:          `"v1"`,
+			requests:      []string{"GET /ledger/account", "", "", "GET /ledger/account"},
+			expectedCalls: 2,
+			expectedLast:  "1",
+		},
+		{
+			description:   "writes invalidate prefix",
+			requests:      []string{"GET /ledger/account/1", "PUT /ledger/account/1", "GET /ledger/account/1"},
+			expectedCalls: 3,
+			expectedLast:  "3",
+		},
+		{
+			description:   "skips paths without ttl",
+			requests:      []string{"GET /customer", "GET /customer"},
+			expectedCalls: 2,
+			expectedLast:  "2",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			require := require.New(t)
+
+			calls := 0
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				calls++
+				if tt.etag != "" {
+					if r.Header.Get("If-None-Match") == tt.etag {
+						w.WriteHeader(http.StatusNotModified)
+						return
+					}
+					w.Header().Set("ETag", tt.etag)
+				}
+				fmt.Fprint(w, calls)
+			}))
+			t.Cleanup(srv.Close)
+
+			now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+			client := withCacheTransport(srv.Client(), map[string]time.Duration{
+				"/ledger/account": 2 * time.Minute,
+				"/ledger/vatType": time.Hour,
+			}, srv.URL+"/v2", func() time.Time { return now })
+
+			var last string
+			for _, r := range tt.requests {
+				now = now.Add(time.Minute)
+				if r == "" {
+					continue
+				}
+				method, path, _ := strings.Cut(r, " ")
+				req, err := http.NewRequest(method, srv.URL+"/v2"+path, nil)
+				require.NoError(err)
+				res, err := client.Do(req)
+				require.NoError(err)
+				body, err := io.ReadAll(res.Body)
+				res.Body.Close()
+				require.NoError(err)
+				require.Equal(http.StatusOK, res.StatusCode)
+				require.Equal("200 OK", res.Status)
+				last = string(body)
+			}
+			require.Equal(tt.expectedCalls, calls)
+			require.Equal(tt.expectedLast, last)
+		})
+	}
+}
+
+func TestCacheTransportEviction(t *testing.T) {
+	require := require.New(t)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, r.URL.Path)
+	}))
+	t.Cleanup(srv.Close)
+
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	client := withCacheTransport(srv.Client(), map[string]time.Duration{"/employee": time.Minute}, srv.URL, func() time.Time { return now })
+	transport := client.Transport.(*cacheTransport)
+
+	get := func(id int) {
+		res, err := client.Get(fmt.Sprintf("%s/employee/%d", srv.URL, id))
+		require.NoError(err)
+		res.Body.Close()
+	}
+	for id := range maxCacheEntries {
+		get(id)
+	}
+	require.Len(transport.entries, maxCacheEntries)
+
+	get(maxCacheEntries)
+	require.Len(transport.entries, maxCacheEntries, "evicts an entry when full")
+
+	now = now.Add(time.Hour)
+	get(maxCacheEntries + 1)
+	require.Len(transport.entries, 1, "evicts expired entries")
+}
+
+func TestCacheTransportConcurrentRevalidation(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		fmt.Fprint(w, "1")
+	}))
+	t.Cleanup(srv.Close)
+
+	var mu sync.Mutex
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	client := withCacheTransport(srv.Client(), map[string]time.Duration{"/company": time.Nanosecond}, srv.URL, func() time.Time {
+		mu.Lock()
+		defer mu.Unlock()
+		now = now.Add(time.Second)
+		return now
+	})
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			res, err := client.Get(srv.URL + "/company")
+			require.NoError(t, err)
+			body, _ := io.ReadAll(res.Body)
+			res.Body.Close()
+			require.Equal(t, "1", string(body))
+		}()
+	}
+	wg.Wait()
+}
@@ -1,19 +1,44 @@
-//go:generate go tool -modfile=go.tool.mod github.com/oapi-codegen/oapi-codegen/v2/cmd/oapi-codegen -config cfg.yaml ./api/openapi.json
+//go:generate go run ./internal/gendomains
 
 package tripletex
 
 import (
 	"fmt"
+	"log/slog"
 	"net/http"
+	"sync"
 	"time"
+
+	"github.com/valuetechdev/tripletex-go/api/models"
 )
 
+// jsonContentType is the request content type used by the Tripletex API.
+const jsonContentType = "application/json; charset=utf-8"
+
 type TripletexClient struct {
-	token         *Token
-	tokenDuration time.Duration
-	credentials   Credentials
-	baseURL       string
-	httpClient    *http.Client
+	tokenMu         sync.Mutex // Guards token
+	token           *Token
+	tokenDuration   time.Duration
+	refreshMargin   time.Duration
+	credentials     Credentials
+	baseURL         string
+	httpClient      *http.Client
+	refDataTTL      time.Duration
+	refData         *RefData
+	resolver        *Resolver
+	dryRun          Spec // Validates requests instead of sending them if set
+	driftSpec       Spec
+	onSchemaDrift   func(drift SchemaDrift)
+	logger          *slog.Logger
+	throttleRetries int
+	fieldsSpec      Spec
+	defaultFields   map[OperationID]string
+	cacheTTLs       map[string]time.Duration
+	now             func() time.Time
+	codec           JSONCodec
+	whoAmI          whoAmICache
+	company         companyCache
+	entitlements    entitlementCache
 	*ClientWithResponses
 }
 
@@ -39,6 +64,43 @@ func WithTokenDuration(duration time.Duration) Option {
 	}
 }
 
+// WithTokenRefreshMargin renews the token when less than margin remains
+// before it expires, eg. 24 hours, so it's renewed before requests start
+// failing. Defaults to 0, renewing it when expired.
+//
+// The margin should be shorter than the token duration, or the token is
+// renewed before every request.
+func WithTokenRefreshMargin(margin time.Duration) Option {
+	return func(tc *TripletexClient) {
+		tc.refreshMargin = margin
+	}
+}
+
+// WithClock sets the function returning the current time, used for token
+// expiry and dates defaulting to today. Defaults to [time.Now].
+//
+// Useful in tests, to move time forward without waiting.
+func WithClock(now func() time.Time) Option {
+	return func(tc *TripletexClient) {
+		tc.now = now
+	}
+}
+
+// WithJSONCodec sets the functions encoding request bodies and decoding
+// response bodies, including the session token. Defaults to encoding/json.
+//
+// Useful to plug a faster implementation for large syncs, eg.
+// WithJSONCodec(gojson.Marshal, gojson.Unmarshal) with github.com/goccy/go-json.
+func WithJSONCodec(marshal func(v any) ([]byte, error), unmarshal func(data []byte, v any) error) Option {
+	return func(tc *TripletexClient) {
+		tc.codec = JSONCodec{MarshalFunc: marshal, UnmarshalFunc: unmarshal}
+	}
+}
+
+// JSONCodec encodes request bodies and decodes response bodies, see
+// [WithJSONCodec].
+type JSONCodec = models.JSONCodec
+
 // WithBaseURLOption sets a custom base URL. Defaults to "https://tripletex.no/v2".
 func WithBaseURLOption(baseURL string) Option {
 	return func(tc *TripletexClient) {
@@ -64,26 +126,47 @@ func WithAccountantClient(clientId int64) Option {
 //
 // You can provide options to customize the client behavior.
 func New(credentials Credentials, options ...Option) *TripletexClient {
-	now := time.Now()
 	client := &TripletexClient{
-		baseURL:       "https://tripletex.no/v2",
-		credentials:   credentials,
-		tokenDuration: now.AddDate(0, 1, 0).Sub(now),
-		httpClient:    http.DefaultClient,
+		baseURL:     "https://tripletex.no/v2",
+		credentials: credentials,
+		httpClient:  http.DefaultClient,
+		refDataTTL:  defaultRefDataTTL,
+		now:         time.Now,
 	}
 
 	for _, option := range options {
 		option(client)
 	}
+	if client.tokenDuration == 0 {
+		now := client.now()
+		client.tokenDuration = now.AddDate(0, 1, 0).Sub(now)
+	}
+	if client.dryRun != nil {
+		client.httpClient = &http.Client{Transport: &dryRunTransport{spec: client.dryRun, baseURL: client.baseURL}}
+	}
+	if client.driftSpec != nil && client.onSchemaDrift != nil {
+		client.httpClient = withDriftTransport(client.httpClient, client.driftSpec, client.baseURL, client.onSchemaDrift)
+	}
+	if client.throttleRetries > 0 {
+		client.httpClient = withRetryTransport(client.httpClient, client.throttleRetries, client.logger, client.now)
+	}
+	client.httpClient = withCorrelationTransport(client.httpClient, client.logger, client.now)
+	if len(client.cacheTTLs) > 0 {
+		client.httpClient = withCacheTransport(client.httpClient, client.cacheTTLs, client.baseURL, client.now)
+	}
 
 	c, err := NewClientWithResponses(
 		client.baseURL,
 		WithRequestEditorFn(client.interceptAuth),
-		WithHTTPClient(client.httpClient))
+		WithRequestEditorFn(client.interceptDefaultFields),
+		WithHTTPClient(client.httpClient),
+		WithCodec(client.codec))
 	if err != nil {
 		panic(fmt.Errorf("tripletex: failed to create new client: %w", err))
 	}
 
 	client.ClientWithResponses = c
+	client.refData = &RefData{client: client, ttl: client.refDataTTL}
+	client.resolver = &Resolver{client: client, ttl: client.refDataTTL}
 	return client
 }
@@ -5,7 +5,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/http/httptest"
 	"os"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -203,6 +206,146 @@ func TestBadResponse(t *testing.T) {
 	require.Nil(res.JSONDefault.Values, "JSONDefault.Values should be nil")
 }
 
+func TestWithClock(t *testing.T) {
+	require := require.New(t)
+
+	now := time.Date(2025, 1, 10, 10, 0, 0, 0, time.UTC)
+	var expirationDates []string
+	mux := http.NewServeMux()
+	mux.HandleFunc("PUT /token/session/:create", func(w http.ResponseWriter, r *http.Request) {
+		expirationDate := r.URL.Query().Get("expirationDate")
+		expirationDates = append(expirationDates, expirationDate)
+		writeTestJSON(w, http.StatusOK, ResponseWrapperSessionToken{Value: &SessionToken{
+			Token:          ptr("test-token"),
+			ExpirationDate: &expirationDate,
+		}})
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	c := New(Credentials{}, WithBaseURLOption(server.URL), WithClock(func() time.Time { return now }))
+	require.NoError(c.CheckAuth())
+	require.Equal([]string{"2025-02-11"}, expirationDates, "should request the first date at or after now plus a month")
+	require.Equal(time.Date(2025, 2, 10, 23, 0, 0, 0, time.UTC), c.GetToken().ExpiresAt.UTC(), "should expire at midnight in Oslo")
+
+	now = time.Date(2025, 2, 10, 22, 59, 0, 0, time.UTC)
+	require.True(c.IsTokenValid())
+	require.NoError(c.CheckAuth())
+	require.Len(expirationDates, 1)
+
+	now = time.Date(2025, 2, 10, 23, 0, 0, 0, time.UTC)
+	require.False(c.IsTokenValid())
+	require.NoError(c.CheckAuth())
+	require.Equal([]string{"2025-02-11", "2025-03-14"}, expirationDates)
+}
+
+func TestWithTokenRefreshMargin(t *testing.T) {
+	require := require.New(t)
+
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	var created int
+	mux := http.NewServeMux()
+	mux.HandleFunc("PUT /token/session/:create", func(w http.ResponseWriter, r *http.Request) {
+		created++
+		writeTestJSON(w, http.StatusOK, ResponseWrapperSessionToken{Value: &SessionToken{
+			Token:          ptr("test-token"),
+			ExpirationDate: ptr(r.URL.Query().Get("expirationDate")),
+		}})
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	c := New(Credentials{}, WithBaseURLOption(server.URL),
+		WithClock(func() time.Time { return now }),
+		WithTokenDuration(7*24*time.Hour),
+		WithTokenRefreshMargin(24*time.Hour))
+	require.NoError(c.CheckAuth())
+	require.Equal(time.Date(2025, 6, 8, 22, 0, 0, 0, time.UTC), c.GetToken().ExpiresAt.UTC())
+
+	now = time.Date(2025, 6, 7, 21, 59, 0, 0, time.UTC)
+	require.True(c.IsTokenValid(), "more than the margin remains")
+
+	now = time.Date(2025, 6, 7, 22, 0, 0, 0, time.UTC)
+	require.False(c.IsTokenValid(), "the margin remains")
+	require.NoError(c.CheckAuth())
+	require.Equal(2, created)
+}
+
+func TestCheckAuthConcurrent(t *testing.T) {
+	require := require.New(t)
+
+	var created atomic.Int32
+	mux := http.NewServeMux()
+	mux.HandleFunc("PUT /token/session/:create", func(w http.ResponseWriter, r *http.Request) {
+		created.Add(1)
+		writeTestJSON(w, http.StatusOK, ResponseWrapperSessionToken{Value: &SessionToken{
+			Token:          ptr("test-token"),
+			ExpirationDate: ptr(time.Now().AddDate(0, 0, 2).Format(time.DateOnly)),
+		}})
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	c := New(Credentials{}, WithBaseURLOption(server.URL))
+	var wg sync.WaitGroup
+	errs := make([]error, 10)
+	for i := range errs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs[i] = c.CheckAuth()
+		}()
+	}
+	wg.Wait()
+	for _, err := range errs {
+		require.NoError(err)
+	}
+	require.Equal(int32(1), created.Load(), "token created once for all callers")
+}
+
+func TestRequestEditorFn(t *testing.T) {
+	require := require.New(t)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /customer", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal("yes", r.Header.Get("X-Edited"))
+		writeTestJSON(w, http.StatusOK, ListResponseCustomer{})
+	})
+	c := newTestClient(t, mux)
+
+	// The RequestEditorFn of the tripletex package is the one of the domains.
+	var edit RequestEditorFn = func(ctx context.Context, req *http.Request) error {
+		req.Header.Set("X-Edited", "yes")
+		return nil
+	}
+	res, err := c.CustomerSearchWithResponse(context.Background(), &CustomerSearchParams{}, edit)
+	require.NoError(err)
+	require.Equal(http.StatusOK, res.StatusCode())
+}
+
+func TestNewClientDeprecated(t *testing.T) {
+	require := require.New(t)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v2/customer", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal("yes", r.Header.Get("X-Edited"))
+		writeTestJSON(w, http.StatusOK, ListResponseCustomer{})
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	c, err := NewClient("http://invalid", WithBaseURL(server.URL+"/v2"), WithRequestEditorFn(func(ctx context.Context, req *http.Request) error {
+		req.Header.Set("X-Edited", "yes")
+		return nil
+	}))
+	require.NoError(err)
+	require.Equal(server.URL+"/v2", c.Server)
+	res, err := c.CustomerSearch(context.Background(), &CustomerSearchParams{})
+	require.NoError(err)
+	defer res.Body.Close()
+	require.Equal(http.StatusOK, res.StatusCode)
+}
+
 // Require environment variable. Panics if not found.
 func mustEnv(env string) string {
 	v, ok := os.LookupEnv(env)
@@ -212,3 +355,34 @@ func mustEnv(env string) string {
 
 	return v
 }
+
+// Returns a [TripletexClient] talking to an [httptest.Server] serving mux.
+//
+// Session token creation is handled by the server, so tests only need to
+// register the endpoints they exercise.
+func newTestClient(t *testing.T, mux *http.ServeMux, options ...Option) *TripletexClient {
+	t.Helper()
+
+	mux.HandleFunc("PUT /token/session/:create", func(w http.ResponseWriter, r *http.Request) {
+		writeTestJSON(w, http.StatusOK, ResponseWrapperSessionToken{Value: &SessionToken{
+			Token:          ptr("test-token"),
+			ExpirationDate: ptr(time.Now().AddDate(0, 0, 2).Format(time.DateOnly)),
+		}})
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	creds := Credentials{ConsumerToken: "consumer", EmployeeToken: "employee"}
+	return New(creds, append([]Option{WithBaseURLOption(server.URL)}, options...)...)
+}
+
+// Writes v as JSON with status.
+func writeTestJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
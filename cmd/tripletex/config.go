@@ -0,0 +1,68 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+)
+
+// config holds the credentials and settings of the CLI.
+type config struct {
+	BaseURL       string `json:"baseUrl"`
+	ConsumerToken string `json:"consumerToken"`
+	EmployeeToken string `json:"employeeToken"`
+	ClientId      int64  `json:"clientId"`
+}
+
+// defaultConfigPath returns the path of the config file used if --config
+// isn't set, eg. ~/.config/tripletex/config.json.
+func defaultConfigPath() string {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "tripletex", "config.json")
+}
+
+// loadConfig reads the config file at path, if it exists, and overrides it
+// with the TRIPLETEX_* environment variables that are set.
+func loadConfig(path string, getenv func(string) string) (config, error) {
+	var cfg config
+	if path != "" {
+		b, err := os.ReadFile(path)
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return cfg, fmt.Errorf("failed to read config: %w", err)
+		}
+		if err == nil {
+			if err = json.Unmarshal(b, &cfg); err != nil {
+				return cfg, fmt.Errorf("failed to parse config %s: %w", path, err)
+			}
+		}
+	}
+
+	if v := getenv("TRIPLETEX_BASE_URL"); v != "" {
+		cfg.BaseURL = v
+	}
+	if v := getenv("TRIPLETEX_CONSUMER_TOKEN"); v != "" {
+		cfg.ConsumerToken = v
+	}
+	if v := getenv("TRIPLETEX_EMPLOYEE_TOKEN"); v != "" {
+		cfg.EmployeeToken = v
+	}
+	if v := getenv("TRIPLETEX_CLIENT_ID"); v != "" {
+		id, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid TRIPLETEX_CLIENT_ID %q: %w", v, err)
+		}
+		cfg.ClientId = id
+	}
+
+	if cfg.ConsumerToken == "" || cfg.EmployeeToken == "" {
+		return cfg, errors.New("missing consumer or employee token, set TRIPLETEX_CONSUMER_TOKEN and TRIPLETEX_EMPLOYEE_TOKEN or add them to the config file")
+	}
+	return cfg, nil
+}
@@ -0,0 +1,345 @@
+// Command tripletex gives ad-hoc access to the Tripletex API, eg. for
+// debugging field selections and permissions.
+//
+// Usage:
+//
+//	tripletex [global flags] <command> [flags] [args]
+//
+// Commands:
+//
+//	<entity> search   Search an entity, eg. "customer search --fields id,name"
+//	get <path>        Send a GET request, eg. "get /customer/1"
+//	post <path>       Send a POST request with the body from --data
+//	put <path>        Send a PUT request with the body from --data
+//	delete <path>     Send a DELETE request
+//	whoami            Show the employee and company of the tokens
+//
+// Credentials are read from the config file (--config, defaults to
+// tripletex/config.json in the user config directory) with the keys baseUrl,
+// consumerToken, employeeToken and clientId, overridden by the environment
+// variables TRIPLETEX_BASE_URL, TRIPLETEX_CONSUMER_TOKEN,
+// TRIPLETEX_EMPLOYEE_TOKEN and TRIPLETEX_CLIENT_ID.
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/valuetechdev/tripletex-go"
+)
+
+const usage = `Usage: tripletex [global flags] <command> [flags] [args]
+
+Commands:
+  <entity> search   Search an entity, eg. "customer search --fields id,name"
+  get <path>        Send a GET request, eg. "get /customer/1"
+  post <path>       Send a POST request with the body from --data
+  put <path>        Send a PUT request with the body from --data
+  delete <path>     Send a DELETE request
+  whoami            Show the employee and company of the tokens
+
+Global flags:
+`
+
+func main() {
+	if err := run(context.Background(), os.Args[1:], os.Stdout); err != nil {
+		fmt.Fprintln(os.Stderr, "tripletex:", err)
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context, args []string, stdout io.Writer) error {
+	global := flag.NewFlagSet("tripletex", flag.ContinueOnError)
+	global.Usage = func() {
+		fmt.Fprint(global.Output(), usage)
+		global.PrintDefaults()
+	}
+	configPath := global.String("config", defaultConfigPath(), "path of the config file")
+	baseURL := global.String("base-url", "", "base URL of the API, eg. https://api-test.tripletex.tech/v2")
+	clientId := global.Int64("client-id", 0, "act as the accountant client with this id")
+	if err := global.Parse(args); err != nil {
+		return err
+	}
+	args = global.Args()
+	if len(args) == 0 {
+		global.Usage()
+		return errors.New("missing command")
+	}
+
+	cfg, err := loadConfig(*configPath, os.Getenv)
+	if err != nil {
+		return err
+	}
+	if *baseURL != "" {
+		cfg.BaseURL = *baseURL
+	}
+	if *clientId != 0 {
+		cfg.ClientId = *clientId
+	}
+	cli := &cli{client: newClient(cfg), stdout: stdout}
+
+	switch cmd := args[0]; cmd {
+	case "get", "post", "put", "delete":
+		return cli.raw(ctx, strings.ToUpper(cmd), args[1:])
+	case "whoami":
+		return cli.raw(ctx, http.MethodGet, []string{"/token/session/>whoAmI"})
+	default:
+		if len(args) < 2 || args[1] != "search" {
+			return fmt.Errorf("unknown command %q, run with -h for usage", strings.Join(args, " "))
+		}
+		return cli.search(ctx, cmd, args[2:])
+	}
+}
+
+func newClient(cfg config) *tripletex.TripletexClient {
+	options := []tripletex.Option{tripletex.WithTokenDuration(24 * time.Hour)}
+	if cfg.BaseURL != "" {
+		options = append(options, tripletex.WithBaseURLOption(cfg.BaseURL))
+	}
+	if cfg.ClientId != 0 {
+		options = append(options, tripletex.WithAccountantClient(cfg.ClientId))
+	}
+	return tripletex.New(tripletex.Credentials{
+		ConsumerToken: cfg.ConsumerToken,
+		EmployeeToken: cfg.EmployeeToken,
+	}, options...)
+}
+
+type cli struct {
+	client *tripletex.TripletexClient
+	stdout io.Writer
+}
+
+// search runs "<entity> search".
+func (c *cli) search(ctx context.Context, entity string, args []string) error {
+	fs := flag.NewFlagSet(entity+" search", flag.ContinueOnError)
+	fields := fs.String("fields", "", "fields to return, eg. 'id,name,postalAddress(city)'")
+	changedSince := fs.String("changed-since", "", "only values changed since this date or time, eg. 2024-01-01")
+	from := fs.Int("from", 0, "index of the first value")
+	count := fs.Int("count", 100, "number of values")
+	asJSON := fs.Bool("json", false, "print the raw JSON response")
+	var params paramsFlag
+	fs.Var(&params, "param", "extra query parameter as key=value, can be repeated")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	q := url.Values(params)
+	if q == nil {
+		q = url.Values{}
+	}
+	if *fields != "" {
+		q.Set("fields", *fields)
+	}
+	if *changedSince != "" {
+		// Dates are sent as midnight, as changedSince takes a date-time
+		if d, err := time.Parse(time.DateOnly, *changedSince); err == nil {
+			*changedSince = d.Format("2006-01-02T15:04:05")
+		}
+		q.Set("changedSince", *changedSince)
+	}
+	q.Set("from", fmt.Sprint(*from))
+	q.Set("count", fmt.Sprint(*count))
+
+	body, err := c.do(ctx, http.MethodGet, "/"+strings.Trim(entity, "/")+"?"+q.Encode(), nil)
+	if err != nil {
+		return err
+	}
+	if *asJSON {
+		return writeIndented(c.stdout, body)
+	}
+
+	var res struct {
+		Values []map[string]any `json:"values"`
+	}
+	if err = json.Unmarshal(body, &res); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	columns := topLevelFields(*fields)
+	if len(columns) == 0 || columns[0] == "*" {
+		columns = []string{"id", "name"}
+	}
+	return writeTable(c.stdout, columns, res.Values)
+}
+
+// raw runs "get", "post", "put" and "delete".
+func (c *cli) raw(ctx context.Context, method string, args []string) error {
+	fs := flag.NewFlagSet(strings.ToLower(method), flag.ContinueOnError)
+	data := fs.String("data", "", "request body, or @file to read it from file, or @- from stdin")
+	positional, err := parseInterspersed(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 1 {
+		return fmt.Errorf("%s takes a single path, eg. /customer/1", strings.ToLower(method))
+	}
+
+	var body []byte
+	switch {
+	case *data == "@-":
+		b, err := io.ReadAll(os.Stdin)
+		if err != nil {
+			return fmt.Errorf("failed to read stdin: %w", err)
+		}
+		body = b
+	case strings.HasPrefix(*data, "@"):
+		b, err := os.ReadFile((*data)[1:])
+		if err != nil {
+			return fmt.Errorf("failed to read body: %w", err)
+		}
+		body = b
+	case *data != "":
+		body = []byte(*data)
+	}
+
+	res, err := c.do(ctx, method, positional[0], body)
+	if err != nil {
+		return err
+	}
+	return writeIndented(c.stdout, res)
+}
+
+// do sends a request to path, relative to the base URL, and returns the
+// response body. Returns an error for non-2xx responses.
+func (c *cli) do(ctx context.Context, method, path string, body []byte) ([]byte, error) {
+	u, err := url.Parse(path)
+	if err != nil {
+		return nil, fmt.Errorf("invalid path %q: %w", path, err)
+	}
+	var reqBody io.Reader
+	if body != nil {
+		reqBody = bytes.NewReader(body)
+	}
+
+	res, err := c.client.Do(ctx, method, u.Path, u.Query(), reqBody)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	b, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+	if err = tripletex.CheckResponse(res, b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// parseInterspersed parses args with fs, allowing flags after positional
+// arguments, and returns the positional arguments.
+func parseInterspersed(fs *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			return nil, err
+		}
+		if fs.NArg() == 0 {
+			return positional, nil
+		}
+		positional = append(positional, fs.Arg(0))
+		args = fs.Args()[1:]
+	}
+}
+
+// paramsFlag collects repeated key=value flags.
+type paramsFlag url.Values
+
+func (p *paramsFlag) String() string {
+	return url.Values(*p).Encode()
+}
+
+func (p *paramsFlag) Set(s string) error {
+	key, value, ok := strings.Cut(s, "=")
+	if !ok {
+		return fmt.Errorf("%q is not key=value", s)
+	}
+	if *p == nil {
+		*p = paramsFlag{}
+	}
+	url.Values(*p).Add(key, value)
+	return nil
+}
+
+// topLevelFields returns the top-level field names of a fields filter, eg.
+// ["id", "postalAddress"] for "id,postalAddress(city)".
+func topLevelFields(fields string) []string {
+	var names []string
+	depth, start := 0, 0
+	for i, r := range fields + "," {
+		switch r {
+		case '(':
+			if depth == 0 {
+				names = append(names, strings.TrimSpace(fields[start:i]))
+			}
+			depth++
+		case ')':
+			depth--
+			start = i + 1
+		case ',':
+			if depth == 0 {
+				if name := strings.TrimSpace(fields[start:i]); name != "" {
+					names = append(names, name)
+				}
+				start = i + 1
+			}
+		}
+	}
+	return names
+}
+
+// writeTable writes values as tab-aligned columns.
+func writeTable(w io.Writer, columns []string, values []map[string]any) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, strings.Join(columns, "\t"))
+	for _, v := range values {
+		cells := make([]string, len(columns))
+		for i, column := range columns {
+			cells[i] = formatCell(v[column])
+		}
+		fmt.Fprintln(tw, strings.Join(cells, "\t"))
+	}
+	return tw.Flush()
+}
+
+// formatCell formats a value for a table cell, with objects and arrays as
+// compact JSON.
+func formatCell(v any) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case map[string]any, []any:
+		b, _ := json.Marshal(v)
+		return string(b)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// writeIndented writes a JSON body indented, or as is if it isn't JSON.
+func writeIndented(w io.Writer, body []byte) error {
+	var buf bytes.Buffer
+	if len(body) == 0 {
+		return nil
+	}
+	if err := json.Indent(&buf, body, "", "  "); err != nil {
+		_, err = w.Write(body)
+		return err
+	}
+	buf.WriteByte('\n')
+	_, err := buf.WriteTo(w)
+	return err
+}
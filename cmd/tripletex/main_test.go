@@ -0,0 +1,112 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/valuetechdev/tripletex-go"
+	"github.com/valuetechdev/tripletex-go/tripletextest"
+)
+
+func TestRun(t *testing.T) {
+	srv := tripletextest.NewServer()
+	t.Cleanup(srv.Close)
+	name, email := "Acme AS", "post@acme.no"
+	id := srv.Seed("customer", tripletex.Customer{Name: &name, Email: &email})
+
+	t.Setenv("TRIPLETEX_CONSUMER_TOKEN", "consumer")
+	t.Setenv("TRIPLETEX_EMPLOYEE_TOKEN", "employee")
+	global := []string{"--config", "", "--base-url", srv.URL}
+
+	tests := []struct {
+		description string
+		args        []string
+		want        []string
+	}{
+		{
+			description: "search as table",
+			args:        []string{"customer", "search", "--fields", "id,name,email"},
+			want:        []string{"id  name     email", "1   Acme AS  post@acme.no"},
+		},
+		{
+			description: "search as json",
+			args:        []string{"customer", "search", "--json", "--param", "email=post@acme.no"},
+			want:        []string{`"name": "Acme AS"`},
+		},
+		{
+			description: "raw get",
+			args:        []string{"get", "/customer/1"},
+			want:        []string{`"email": "post@acme.no"`},
+		},
+		{
+			description: "raw put",
+			args:        []string{"put", "/customer/1", "--data", `{"name":"Acme ASA"}`},
+			want:        []string{`"name": "Acme ASA"`, `"version": 1`},
+		},
+		{
+			description: "whoami",
+			args:        []string{"whoami"},
+			want:        []string{`"companyId": 1`},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			require := require.New(t)
+
+			var stdout bytes.Buffer
+			require.NoError(run(context.Background(), append(global, tt.args...), &stdout))
+			for _, want := range tt.want {
+				require.Contains(stdout.String(), want)
+			}
+		})
+	}
+	require.Equal(t, int64(1), id)
+}
+
+func TestRunAPIError(t *testing.T) {
+	srv := tripletextest.NewServer()
+	t.Cleanup(srv.Close)
+	t.Setenv("TRIPLETEX_CONSUMER_TOKEN", "consumer")
+	t.Setenv("TRIPLETEX_EMPLOYEE_TOKEN", "employee")
+
+	err := run(context.Background(), []string{"--config", "", "--base-url", srv.URL, "get", "/customer/42"}, &bytes.Buffer{})
+	var apiErr *tripletex.APIError
+	require.ErrorAs(t, err, &apiErr)
+	require.Equal(t, 404, apiErr.Status)
+}
+
+func TestTopLevelFields(t *testing.T) {
+	tests := []struct {
+		fields string
+		want   []string
+	}{
+		{"", nil},
+		{"*", []string{"*"}},
+		{"id, name", []string{"id", "name"}},
+		{"id,postalAddress(city,country(id)),name", []string{"id", "postalAddress", "name"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.fields, func(t *testing.T) {
+			require.Equal(t, tt.want, topLevelFields(tt.fields))
+		})
+	}
+}
+
+func TestLoadConfig(t *testing.T) {
+	require := require.New(t)
+
+	env := map[string]string{
+		"TRIPLETEX_CONSUMER_TOKEN": "consumer",
+		"TRIPLETEX_EMPLOYEE_TOKEN": "employee",
+		"TRIPLETEX_CLIENT_ID":      "42",
+	}
+	cfg, err := loadConfig("does-not-exist.json", func(key string) string { return env[key] })
+	require.NoError(err)
+	require.Equal(config{ConsumerToken: "consumer", EmployeeToken: "employee", ClientId: 42}, cfg)
+
+	_, err = loadConfig("", func(string) string { return "" })
+	require.True(strings.HasPrefix(err.Error(), "missing consumer or employee token"))
+}
@@ -0,0 +1,55 @@
+package tripletex
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// companyCache caches the [Company] of a client.
+type companyCache struct {
+	mu      sync.Mutex
+	company *Company
+}
+
+// CompanyID returns the id of the company of the session token, eg. to tag
+// records synced from several companies.
+//
+// It's looked up with [TripletexClient.WhoAmI] and cached the same way.
+func (c *TripletexClient) CompanyID(ctx context.Context) (int64, error) {
+	whoAmI, err := c.WhoAmI(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return whoAmI.CompanyId, nil
+}
+
+// CompanyInfo returns the company of the session token.
+//
+// It's fetched on first use and cached for the lifetime of c. Failed lookups
+// aren't cached. The returned company must not be modified.
+func (c *TripletexClient) CompanyInfo(ctx context.Context) (*Company, error) {
+	companyId, err := c.CompanyID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	c.company.mu.Lock()
+	defer c.company.mu.Unlock()
+	if c.company.company != nil {
+		return c.company.company, nil
+	}
+
+	res, err := c.CompanyGetWithResponse(ctx, companyId, &CompanyGetParams{})
+	if err != nil {
+		return nil, fmt.Errorf("tripletex: company: failed to get company %d: %w", companyId, err)
+	}
+	if err = checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return nil, fmt.Errorf("tripletex: company: failed to get company %d: %w", companyId, err)
+	}
+	if res.JSONDefault == nil || res.JSONDefault.Value == nil {
+		return nil, fmt.Errorf("tripletex: company: company %d is empty", companyId)
+	}
+	c.company.company = res.JSONDefault.Value
+	return c.company.company, nil
+}
@@ -0,0 +1,44 @@
+package tripletex
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompanyInfo(t *testing.T) {
+	require := require.New(t)
+
+	whoAmICalls, companyCalls := 0, 0
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /token/session/>whoAmI", func(w http.ResponseWriter, r *http.Request) {
+		whoAmICalls++
+		writeTestJSON(w, http.StatusOK, ResponseWrapperLoggedInUserInfo{Value: &LoggedInUserInfo{
+			EmployeeId: ptr(int32(7)),
+			CompanyId:  ptr(int32(42)),
+		}})
+	})
+	mux.HandleFunc("GET /company/{id}", func(w http.ResponseWriter, r *http.Request) {
+		companyCalls++
+		require.Equal("42", r.PathValue("id"))
+		writeTestJSON(w, http.StatusOK, ResponseWrapperCompany{Value: &Company{
+			Id:   ptr(int64(42)),
+			Name: ptr("Acme AS"),
+		}})
+	})
+	c := newTestClient(t, mux)
+
+	for range 2 {
+		companyId, err := c.CompanyID(context.Background())
+		require.NoError(err)
+		require.Equal(int64(42), companyId)
+
+		company, err := c.CompanyInfo(context.Background())
+		require.NoError(err)
+		require.Equal("Acme AS", *company.Name)
+	}
+	require.Equal(1, whoAmICalls, "should cache whoAmI")
+	require.Equal(1, companyCalls, "should cache company")
+}
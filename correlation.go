@@ -0,0 +1,112 @@
+package tripletex
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+// CorrelationIdHeader is the request header carrying the correlation id of a
+// request, see [ContextWithCorrelationId].
+const CorrelationIdHeader = "X-Correlation-Id"
+
+// requestIdHeader is the response header carrying the id Tripletex assigned
+// to a request.
+const requestIdHeader = "x-tlx-request-id"
+
+type correlationIdKey struct{}
+
+// ContextWithCorrelationId returns a copy of ctx making requests sent with it
+// use correlationId, eg. the id of an incoming request, instead of a
+// generated one.
+func ContextWithCorrelationId(ctx context.Context, correlationId string) context.Context {
+	return context.WithValue(ctx, correlationIdKey{}, correlationId)
+}
+
+// WithLogger logs each request with logger, with its correlation id and the
+// request id assigned by Tripletex. Successful requests are logged at debug
+// level, others at warn level. Defaults to no logging.
+func WithLogger(logger *slog.Logger) Option {
+	return func(tc *TripletexClient) {
+		tc.logger = logger
+	}
+}
+
+// correlationTransport sends a correlation id with each request to next, and
+// logs requests to logger if not nil.
+//
+// The id is taken from the request context, see [ContextWithCorrelationId],
+// or generated. It's included in [APIError] through the request of the
+// response, and in transport errors.
+type correlationTransport struct {
+	next   http.RoundTripper
+	logger *slog.Logger
+	now    func() time.Time
+}
+
+// withCorrelationTransport returns a copy of client sending correlation ids.
+func withCorrelationTransport(client *http.Client, logger *slog.Logger, now func() time.Time) *http.Client {
+	next := client.Transport
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	c := *client
+	c.Transport = &correlationTransport{next: next, logger: logger, now: now}
+	return &c
+}
+
+func (t *correlationTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	correlationId := req.Header.Get(CorrelationIdHeader)
+	if correlationId == "" {
+		correlationId, _ = req.Context().Value(correlationIdKey{}).(string)
+		if correlationId == "" {
+			correlationId = newCorrelationId()
+		}
+		req = req.Clone(req.Context())
+		req.Header.Set(CorrelationIdHeader, correlationId)
+	}
+
+	start := t.now()
+	res, err := t.next.RoundTrip(req)
+	if err != nil {
+		t.log(req.Context(), slog.LevelWarn, req, correlationId, start,
+			slog.String("error", err.Error()))
+		return nil, fmt.Errorf("%w [correlationId=%s]", err, correlationId)
+	}
+	if res.Request == nil {
+		res.Request = req
+	}
+
+	level := slog.LevelDebug
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		level = slog.LevelWarn
+	}
+	t.log(req.Context(), level, req, correlationId, start,
+		slog.Int("status", res.StatusCode),
+		slog.String("requestId", res.Header.Get(requestIdHeader)))
+	return res, nil
+}
+
+func (t *correlationTransport) log(ctx context.Context, level slog.Level, req *http.Request, correlationId string, start time.Time, attrs ...slog.Attr) {
+	if t.logger == nil || !t.logger.Enabled(ctx, level) {
+		return
+	}
+	attrs = append([]slog.Attr{
+		slog.String("method", req.Method),
+		slog.String("path", req.URL.Path),
+		slog.String("correlationId", correlationId),
+		slog.Duration("duration", t.now().Sub(start)),
+	}, attrs...)
+	t.logger.LogAttrs(ctx, level, "tripletex: request", attrs...)
+}
+
+// newCorrelationId returns a random 128-bit id in hex.
+func newCorrelationId() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,66 @@
+package tripletex
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCorrelationId(t *testing.T) {
+	require := require.New(t)
+
+	var ids []string
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /customer/{id}", func(w http.ResponseWriter, r *http.Request) {
+		ids = append(ids, r.Header.Get(CorrelationIdHeader))
+		w.Header().Set("x-tlx-request-id", "tlx-1")
+		writeTestJSON(w, http.StatusNotFound, APIError{Status: http.StatusNotFound, Message: "Object not found"})
+	})
+	var logs bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&logs, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	c := newTestClient(t, mux, WithLogger(logger))
+
+	tests := []struct {
+		description string
+		ctx         context.Context
+		want        string
+	}{
+		{description: "generated", ctx: context.Background()},
+		{description: "from context", ctx: ContextWithCorrelationId(context.Background(), "incoming-1"), want: "incoming-1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			ids = nil
+			res, err := c.CustomerGetWithResponse(tt.ctx, 1, &CustomerGetParams{})
+			require.NoError(err)
+			err = checkResponse(res.HTTPResponse, res.Body)
+
+			var apiErr *APIError
+			require.True(errors.As(err, &apiErr))
+			require.Len(ids, 1)
+			require.NotEmpty(ids[0])
+			if tt.want != "" {
+				require.Equal(tt.want, ids[0])
+			}
+			require.Equal(ids[0], apiErr.CorrelationId, "should include the correlation id in errors")
+			require.Equal("tlx-1", apiErr.RequestId)
+			require.Contains(err.Error(), "[requestId=tlx-1] [correlationId="+ids[0]+"]")
+			require.Contains(logs.String(), "correlationId="+ids[0])
+		})
+	}
+}
+
+func TestCorrelationIdTransportError(t *testing.T) {
+	require := require.New(t)
+
+	c := New(Credentials{}, WithBaseURLOption("http://127.0.0.1:0"))
+	c.SetToken(&Token{AccessToken: "token", ExpiresAt: c.now().AddDate(0, 0, 1)})
+
+	_, err := c.CustomerGetWithResponse(ContextWithCorrelationId(context.Background(), "incoming-1"), 1, &CustomerGetParams{})
+	require.ErrorContains(err, "[correlationId=incoming-1]")
+}
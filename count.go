@@ -0,0 +1,83 @@
+package tripletex
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+)
+
+// Count returns the number of values matching a search, from the
+// fullResultSize of its list envelope, without transferring the values.
+//
+// op is a generated search method, called with a copy of params requesting a
+// single value with only its id:
+//
+//	n, err := tripletex.Count(ctx, c.CustomerSearchWithResponse, &tripletex.CustomerSearchParams{IsInactive: ptr(false)})
+//
+// Tripletex documents fullResultSize as not exact for some endpoints.
+func Count[P any, E any, R interface{ StatusCode() int }](ctx context.Context, op func(ctx context.Context, params *P, reqEditors ...E) (R, error), params *P) (int64, error) {
+	var p P
+	if params != nil {
+		p = *params
+	}
+	v := reflect.ValueOf(&p).Elem()
+	if v.Kind() != reflect.Struct {
+		return 0, fmt.Errorf("tripletex: count: %T is not a params struct", p)
+	}
+	for _, param := range []struct {
+		name  string
+		value any
+	}{{"Fields", "id"}, {"From", 0}, {"Count", 1}} {
+		if err := setParam(v, param.name, param.value); err != nil {
+			return 0, fmt.Errorf("tripletex: count: %T: %w", p, err)
+		}
+	}
+
+	res, err := op(ctx, &p)
+	if err != nil {
+		return 0, fmt.Errorf("tripletex: count: failed to search: %w", err)
+	}
+	rv := reflect.ValueOf(res)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return 0, fmt.Errorf("tripletex: count: %T is not a generated response", res)
+	}
+	rv = rv.Elem()
+	httpResponse, _ := rv.FieldByName("HTTPResponse").Interface().(*http.Response)
+	body, _ := rv.FieldByName("Body").Interface().([]byte)
+	if err = checkResponse(httpResponse, body); err != nil {
+		return 0, fmt.Errorf("tripletex: count: failed to search: %w", err)
+	}
+
+	list := rv.FieldByName("JSONDefault")
+	if !list.IsValid() || list.Kind() != reflect.Pointer {
+		return 0, fmt.Errorf("tripletex: count: %T is not a list response", res)
+	}
+	if list.IsNil() {
+		return 0, fmt.Errorf("tripletex: count: list response is empty")
+	}
+	size := list.Elem().FieldByName("FullResultSize")
+	if !size.IsValid() || size.Type() != reflect.TypeFor[*int64]() {
+		return 0, fmt.Errorf("tripletex: count: %T is not a list response", res)
+	}
+	if size.IsNil() {
+		return 0, fmt.Errorf("tripletex: count: fullResultSize is empty")
+	}
+	return size.Elem().Int(), nil
+}
+
+// setParam sets the pointer field name of the params struct v to value.
+func setParam(v reflect.Value, name string, value any) error {
+	f := v.FieldByName(name)
+	if !f.IsValid() || f.Kind() != reflect.Pointer {
+		return fmt.Errorf("no %s parameter", name)
+	}
+	val := reflect.ValueOf(value)
+	if !val.CanConvert(f.Type().Elem()) {
+		return fmt.Errorf("%s parameter is %s", name, f.Type())
+	}
+	ptr := reflect.New(f.Type().Elem())
+	ptr.Elem().Set(val.Convert(f.Type().Elem()))
+	f.Set(ptr)
+	return nil
+}
@@ -0,0 +1,48 @@
+package tripletex
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCount(t *testing.T) {
+	require := require.New(t)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /customer", func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		require.Equal("id", q.Get("fields"))
+		require.Equal("0", q.Get("from"))
+		require.Equal("1", q.Get("count"))
+		require.Equal("false", q.Get("isInactive"))
+		writeTestJSON(w, http.StatusOK, ListResponseCustomer{
+			FullResultSize: ptr(int64(42)),
+			Values:         &[]Customer{{Id: ptr(int64(1))}},
+		})
+	})
+	mux.HandleFunc("GET /supplier", func(w http.ResponseWriter, r *http.Request) {
+		writeTestJSON(w, http.StatusForbidden, APIError{Status: http.StatusForbidden, Message: "You do not have access"})
+	})
+	mux.HandleFunc("GET /token/session/>whoAmI", func(w http.ResponseWriter, r *http.Request) {
+		writeTestJSON(w, http.StatusOK, ResponseWrapperLoggedInUserInfo{Value: &LoggedInUserInfo{EmployeeId: ptr(int32(1))}})
+	})
+	c := newTestClient(t, mux)
+
+	params := &CustomerSearchParams{IsInactive: ptr(false), Count: ptr(100)}
+	n, err := Count(context.Background(), c.CustomerSearchWithResponse, params)
+	require.NoError(err)
+	require.Equal(int64(42), n)
+	require.Equal(100, *params.Count, "params should not be modified")
+	require.Nil(params.Fields)
+
+	_, err = Count(context.Background(), c.SupplierSearchWithResponse, nil)
+	var apiErr *APIError
+	require.ErrorAs(err, &apiErr)
+	require.Equal(http.StatusForbidden, apiErr.Status)
+
+	_, err = Count(context.Background(), c.TokenSessionWhoAmIWhoAmIWithResponse, nil)
+	require.ErrorContains(err, "no From parameter")
+}
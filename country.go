@@ -0,0 +1,101 @@
+package tripletex
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// Countries returns all countries.
+func (r *RefData) Countries(ctx context.Context) ([]Country, error) {
+	return r.countries.get(ctx, r.ttl, func(ctx context.Context, from, count int) ([]Country, PageInfo, error) {
+		res, err := r.client.CountrySearchWithResponse(ctx, &CountrySearchParams{
+			From:  &from,
+			Count: &count,
+		})
+		if err != nil {
+			return nil, PageInfo{}, fmt.Errorf("tripletex: refdata: failed to search countries: %w", err)
+		}
+		if err = checkResponse(res.HTTPResponse, res.Body); err != nil {
+			return nil, PageInfo{}, fmt.Errorf("tripletex: refdata: failed to search countries: %w", err)
+		}
+		if res.JSONDefault == nil {
+			return nil, PageInfo{}, nil
+		}
+		return listValues(res.JSONDefault.Values), res.JSONDefault.PageInfo(), nil
+	})
+}
+
+// CountryByCode returns the country with ISO 3166-1 alpha-2 or alpha-3 code,
+// eg. "NO" or "NOR". The code is matched case-insensitively.
+func (r *RefData) CountryByCode(ctx context.Context, code string) (*Country, error) {
+	countries, err := r.Countries(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if c, ok := findRef(countries, func(c Country) bool {
+		return (c.IsoAlpha2Code != nil && strings.EqualFold(*c.IsoAlpha2Code, code)) ||
+			(c.IsoAlpha3Code != nil && strings.EqualFold(*c.IsoAlpha3Code, code))
+	}); ok {
+		return c, nil
+	}
+	return nil, fmt.Errorf("%w: country %q", ErrNotFound, code)
+}
+
+// CountryByName returns the country with name, eg. "Norge". The name is
+// matched case-insensitively.
+func (r *RefData) CountryByName(ctx context.Context, name string) (*Country, error) {
+	countries, err := r.Countries(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if c, ok := findRef(countries, func(c Country) bool { return c.Name != nil && strings.EqualFold(*c.Name, name) }); ok {
+		return c, nil
+	}
+	return nil, fmt.Errorf("%w: country %q", ErrNotFound, name)
+}
+
+// Municipalities returns all Norwegian municipalities.
+func (r *RefData) Municipalities(ctx context.Context) ([]Municipality, error) {
+	return r.municipalities.get(ctx, r.ttl, func(ctx context.Context, from, count int) ([]Municipality, PageInfo, error) {
+		res, err := r.client.MunicipalitySearchWithResponse(ctx, &MunicipalitySearchParams{
+			From:  &from,
+			Count: &count,
+		})
+		if err != nil {
+			return nil, PageInfo{}, fmt.Errorf("tripletex: refdata: failed to search municipalities: %w", err)
+		}
+		if err = checkResponse(res.HTTPResponse, res.Body); err != nil {
+			return nil, PageInfo{}, fmt.Errorf("tripletex: refdata: failed to search municipalities: %w", err)
+		}
+		if res.JSONDefault == nil {
+			return nil, PageInfo{}, nil
+		}
+		return listValues(res.JSONDefault.Values), res.JSONDefault.PageInfo(), nil
+	})
+}
+
+// MunicipalityByNumber returns the municipality with number, eg. "0301".
+func (r *RefData) MunicipalityByNumber(ctx context.Context, number string) (*Municipality, error) {
+	municipalities, err := r.Municipalities(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if m, ok := findRef(municipalities, func(m Municipality) bool { return m.Number != nil && *m.Number == number }); ok {
+		return m, nil
+	}
+	return nil, fmt.Errorf("%w: municipality %q", ErrNotFound, number)
+}
+
+// MunicipalityByName returns the municipality with name, eg. "Oslo". The name
+// is matched case-insensitively.
+func (r *RefData) MunicipalityByName(ctx context.Context, name string) (*Municipality, error) {
+	municipalities, err := r.Municipalities(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if m, ok := findRef(municipalities, func(m Municipality) bool { return m.Name != nil && strings.EqualFold(*m.Name, name) }); ok {
+		return m, nil
+	}
+	return nil, fmt.Errorf("%w: municipality %q", ErrNotFound, name)
+}
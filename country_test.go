@@ -0,0 +1,51 @@
+package tripletex
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRefDataCountriesAndMunicipalities(t *testing.T) {
+	require := require.New(t)
+
+	requests := make(map[string]int)
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /country", func(w http.ResponseWriter, r *http.Request) {
+		requests["country"]++
+		writeTestJSON(w, http.StatusOK, ListResponseCountry{Values: &[]Country{
+			{Id: ptr(int64(161)), Name: ptr("Norge"), IsoAlpha2Code: ptr("NO"), IsoAlpha3Code: ptr("NOR")},
+			{Id: ptr(int64(5)), Name: ptr("Sverige"), IsoAlpha2Code: ptr("SE"), IsoAlpha3Code: ptr("SWE")},
+		}})
+	})
+	mux.HandleFunc("GET /municipality", func(w http.ResponseWriter, r *http.Request) {
+		requests["municipality"]++
+		writeTestJSON(w, http.StatusOK, ListResponseMunicipality{Values: &[]Municipality{
+			{Id: ptr(int64(301)), Name: ptr("Oslo"), Number: ptr("0301")},
+		}})
+	})
+	c := newTestClient(t, mux)
+	ctx := context.Background()
+
+	for _, code := range []string{"NO", "nor"} {
+		country, err := c.RefData().CountryByCode(ctx, code)
+		require.NoError(err)
+		require.Equal(int64(161), *country.Id)
+	}
+	country, err := c.RefData().CountryByName(ctx, "sverige")
+	require.NoError(err)
+	require.Equal(int64(5), *country.Id)
+	_, err = c.RefData().CountryByCode(ctx, "XX")
+	require.ErrorIs(err, ErrNotFound)
+
+	municipality, err := c.RefData().MunicipalityByNumber(ctx, "0301")
+	require.NoError(err)
+	require.Equal(int64(301), *municipality.Id)
+	municipality, err = c.RefData().MunicipalityByName(ctx, "OSLO")
+	require.NoError(err)
+	require.Equal(int64(301), *municipality.Id)
+
+	require.Equal(map[string]int{"country": 1, "municipality": 1}, requests)
+}
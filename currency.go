@@ -0,0 +1,128 @@
+package tripletex
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// companyCurrency is the currency Tripletex exchange rates are quoted in.
+const companyCurrency = "NOK"
+
+// CurrencyService converts amounts between currencies using the Tripletex
+// exchange rates.
+//
+// Use [TripletexClient.Currency] to get one.
+type CurrencyService struct {
+	client *TripletexClient
+}
+
+// Currency returns a [CurrencyService] using c.
+func (c *TripletexClient) Currency() *CurrencyService {
+	return &CurrencyService{client: c}
+}
+
+// maxCachedRates is the number of exchange rates kept by [RefData]. Expired
+// rates are evicted when reached, and then an arbitrary one if none has
+// expired.
+const maxCachedRates = 1024
+
+// rateKey identifies an exchange rate of a currency on a date.
+type rateKey struct {
+	currencyId int64
+	date       string
+}
+
+// cachedRate is an exchange rate to NOK, for factor units of a currency.
+type cachedRate struct {
+	rate     float64
+	factor   float64
+	loadedAt time.Time
+}
+
+// Convert converts amount from the currency with code from to the currency
+// with code to, eg. "EUR" to "NOK", using the exchange rates effective on
+// date.
+//
+// Exchange rates are cached like other [RefData].
+func (s *CurrencyService) Convert(ctx context.Context, amount float64, from, to string, date time.Time) (float64, error) {
+	if strings.EqualFold(from, to) {
+		return amount, nil
+	}
+
+	fromRate, err := s.NOKRate(ctx, from, date)
+	if err != nil {
+		return 0, err
+	}
+	toRate, err := s.NOKRate(ctx, to, date)
+	if err != nil {
+		return 0, err
+	}
+	return amount * fromRate / toRate, nil
+}
+
+// NOKRate returns the value in NOK of one unit of the currency with code, eg.
+// "EUR", on date.
+func (s *CurrencyService) NOKRate(ctx context.Context, code string, date time.Time) (float64, error) {
+	if strings.EqualFold(code, companyCurrency) {
+		return 1, nil
+	}
+
+	refData := s.client.RefData()
+	currency, err := refData.CurrencyByCode(ctx, code)
+	if err != nil {
+		return 0, err
+	}
+	key := rateKey{currencyId: *currency.Id, date: date.Format(time.DateOnly)}
+
+	refData.mu.Lock()
+	cached, ok := refData.rates[key]
+	refData.mu.Unlock()
+	if ok && time.Since(cached.loadedAt) < refData.ttl {
+		return cached.rate / cached.factor, nil
+	}
+
+	res, err := s.client.CurrencyRateGetRateWithResponse(ctx, key.currencyId, &CurrencyRateGetRateParams{Date: key.date})
+	if err != nil {
+		return 0, fmt.Errorf("tripletex: currency: failed to get %s rate on %s: %w", code, key.date, err)
+	}
+	if err = checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return 0, fmt.Errorf("tripletex: currency: failed to get %s rate on %s: %w", code, key.date, err)
+	}
+	if res.JSONDefault == nil || res.JSONDefault.Value == nil || res.JSONDefault.Value.Rate == nil || *res.JSONDefault.Value.Rate == 0 {
+		return 0, fmt.Errorf("tripletex: currency: %s rate on %s is empty", code, key.date)
+	}
+
+	// Rates of some currencies, eg. SEK, are quoted per 100 units.
+	cached = cachedRate{rate: float64(*res.JSONDefault.Value.Rate), factor: 1, loadedAt: time.Now()}
+	if currency.Factor != nil && *currency.Factor > 0 {
+		cached.factor = float64(*currency.Factor)
+	}
+
+	refData.storeRate(key, cached)
+	return cached.rate / cached.factor, nil
+}
+
+// storeRate caches rate with key, evicting rates if the cache is full.
+func (r *RefData) storeRate(key rateKey, rate cachedRate) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.rates == nil {
+		r.rates = make(map[rateKey]cachedRate)
+	}
+	if _, ok := r.rates[key]; !ok && len(r.rates) >= maxCachedRates {
+		for k, cached := range r.rates {
+			if time.Since(cached.loadedAt) >= r.ttl {
+				delete(r.rates, k)
+			}
+		}
+		for k := range r.rates {
+			if len(r.rates) < maxCachedRates {
+				break
+			}
+			delete(r.rates, k)
+		}
+	}
+	r.rates[key] = rate
+}
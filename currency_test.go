@@ -0,0 +1,80 @@
+package tripletex
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCurrencyConvert(t *testing.T) {
+	require := require.New(t)
+
+	rateRequests := 0
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /currency", func(w http.ResponseWriter, r *http.Request) {
+		writeTestJSON(w, http.StatusOK, ListResponseCurrency{Values: &[]Currency{
+			{Id: ptr(int64(1)), Code: ptr("NOK"), Factor: ptr(int32(1))},
+			{Id: ptr(int64(2)), Code: ptr("EUR"), Factor: ptr(int32(1))},
+			{Id: ptr(int64(3)), Code: ptr("SEK"), Factor: ptr(int32(100))},
+		}})
+	})
+	mux.HandleFunc("GET /currency/{id}/rate", func(w http.ResponseWriter, r *http.Request) {
+		rateRequests++
+		require.Equal("2025-03-03", r.URL.Query().Get("date"))
+		rates := map[string]float32{"2": 11.5, "3": 100}
+		writeTestJSON(w, http.StatusOK, ResponseWrapperCurrencyExchangeRate{Value: &CurrencyExchangeRate{
+			Rate: ptr(rates[r.PathValue("id")]),
+		}})
+	})
+	c := newTestClient(t, mux)
+	ctx := context.Background()
+	date := time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		description string
+		amount      float64
+		from        string
+		to          string
+		expected    float64
+	}{
+		{description: "to NOK", amount: 100, from: "EUR", to: "NOK", expected: 1150},
+		{description: "from NOK", amount: 1150, from: "NOK", to: "eur", expected: 100},
+		{description: "with factor", amount: 1000, from: "SEK", to: "NOK", expected: 1000},
+		{description: "between foreign currencies", amount: 100, from: "EUR", to: "SEK", expected: 1150},
+		{description: "same currency", amount: 42, from: "EUR", to: "EUR", expected: 42},
+	}
+
+	for _, test := range tests {
+		converted, err := c.Currency().Convert(ctx, test.amount, test.from, test.to, date)
+		require.NoError(err, test.description)
+		require.InDelta(test.expected, converted, 0.001, test.description)
+	}
+	require.Equal(2, rateRequests)
+
+	_, err := c.Currency().Convert(ctx, 1, "XYZ", "NOK", date)
+	require.ErrorIs(err, ErrNotFound)
+}
+
+func TestRefDataStoreRateEviction(t *testing.T) {
+	require := require.New(t)
+
+	r := &RefData{ttl: time.Hour}
+	for i := range maxCachedRates {
+		r.storeRate(rateKey{currencyId: 1, date: fmt.Sprint(i)}, cachedRate{rate: 1, factor: 1, loadedAt: time.Now()})
+	}
+	require.Len(r.rates, maxCachedRates)
+
+	r.storeRate(rateKey{currencyId: 2}, cachedRate{rate: 1, factor: 1, loadedAt: time.Now()})
+	require.Len(r.rates, maxCachedRates, "evicts a rate when full")
+
+	for k, cached := range r.rates {
+		cached.loadedAt = time.Now().Add(-2 * time.Hour)
+		r.rates[k] = cached
+	}
+	r.storeRate(rateKey{currencyId: 3}, cachedRate{rate: 1, factor: 1, loadedAt: time.Now()})
+	require.Len(r.rates, 1, "evicts expired rates")
+}
@@ -0,0 +1,15 @@
+package tripletex
+
+// Tripletex dates are YYYY-MM-DD strings, which sort chronologically, so they
+// are compared as strings without parsing.
+
+// dateBefore reports whether date is set and before end.
+func dateBefore(date *string, end string) bool {
+	return date != nil && *date != "" && *date < end
+}
+
+// dateInRange reports whether date is set and in the range from (inclusive)
+// to to (exclusive).
+func dateInRange(date *string, from, to string) bool {
+	return date != nil && *date != "" && *date >= from && *date < to
+}
@@ -0,0 +1,46 @@
+package tripletex
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDateBefore(t *testing.T) {
+	tests := []struct {
+		description string
+		date        *string
+		expected    bool
+	}{
+		{description: "nil", date: nil, expected: false},
+		{description: "empty", date: ptr(""), expected: false},
+		{description: "before", date: ptr("2024-12-31"), expected: true},
+		{description: "same", date: ptr("2025-01-01"), expected: false},
+		{description: "after", date: ptr("2025-01-02"), expected: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			require.Equal(t, tt.expected, dateBefore(tt.date, "2025-01-01"))
+		})
+	}
+}
+
+func TestDateInRange(t *testing.T) {
+	tests := []struct {
+		description string
+		date        *string
+		expected    bool
+	}{
+		{description: "nil", date: nil, expected: false},
+		{description: "empty", date: ptr(""), expected: false},
+		{description: "before", date: ptr("2024-12-31"), expected: false},
+		{description: "from", date: ptr("2025-01-01"), expected: true},
+		{description: "inside", date: ptr("2025-01-15"), expected: true},
+		{description: "to", date: ptr("2025-02-01"), expected: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			require.Equal(t, tt.expected, dateInRange(tt.date, "2025-01-01", "2025-02-01"))
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package tripletex
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"strings"
+)
+
+// OperationID identifies an operation of the API, by its method name without
+// the WithResponse suffix, eg. "CustomerSearch", or its OpenAPI operation id,
+// eg. "Customer_search".
+type OperationID string
+
+// WithDefaultFields sets the fields parameter of requests to the operations
+// in fields, when the caller leaves it nil, eg. to always get the same fields
+// of customers across a codebase:
+//
+//	tripletex.WithDefaultFields(map[tripletex.OperationID]string{
+//		"CustomerSearch": "id,name,organizationNumber",
+//		"CustomerGet":    "id,name,organizationNumber",
+//	})
+//
+// Operations are found with spec, eg. spec.Bundled(), which is loaded on the
+// first request.
+func WithDefaultFields(spec Spec, fields map[OperationID]string) Option {
+	return func(tc *TripletexClient) {
+		tc.fieldsSpec = spec
+		tc.defaultFields = fields
+	}
+}
+
+// interceptDefaultFields sets the fields parameter of r from
+// [WithDefaultFields] if missing.
+func (c *TripletexClient) interceptDefaultFields(ctx context.Context, r *http.Request) error {
+	q := r.URL.Query()
+	if c.fieldsSpec == nil || len(c.defaultFields) == 0 || q.Has("fields") {
+		return nil
+	}
+	operationId, params, err := c.fieldsSpec.Operation(r, c.baseURL)
+	if err != nil {
+		return nil // Unknown operations are sent as is
+	}
+
+	fields, ok := c.defaultFields[OperationID(operationId)]
+	if !ok {
+		fields, ok = c.defaultFields[OperationID(operationMethodName(operationId))]
+	}
+	if !ok || !slices.Contains(params, "fields") {
+		return nil
+	}
+	q.Set("fields", fields)
+	r.URL.RawQuery = q.Encode()
+	return nil
+}
+
+// operationMethodName returns the method name of the operation with
+// operationId, eg. "CustomerSearch" for "Customer_search".
+func operationMethodName(operationId string) string {
+	parts := strings.Split(operationId, "_")
+	for i, part := range parts {
+		if part != "" {
+			parts[i] = strings.ToUpper(part[:1]) + part[1:]
+		}
+	}
+	return strings.Join(parts, "")
+}
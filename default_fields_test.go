@@ -0,0 +1,67 @@
+package tripletex
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/valuetechdev/tripletex-go/spec"
+)
+
+func TestWithDefaultFields(t *testing.T) {
+	var fields []string
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /customer", func(w http.ResponseWriter, r *http.Request) {
+		fields = append(fields, r.URL.Query().Get("fields"))
+		writeTestJSON(w, http.StatusOK, ListResponseCustomer{})
+	})
+	mux.HandleFunc("GET /customer/{id}", func(w http.ResponseWriter, r *http.Request) {
+		fields = append(fields, r.URL.Query().Get("fields"))
+		writeTestJSON(w, http.StatusOK, ResponseWrapperCustomer{})
+	})
+	c := newTestClient(t, mux, WithDefaultFields(spec.Bundled(), map[OperationID]string{
+		"CustomerSearch": "id,name",
+		"Customer_get":   "id,email",
+	}))
+	ctx := context.Background()
+
+	tests := []struct {
+		description string
+		call        func() error
+		expected    string
+	}{
+		{
+			description: "by method name",
+			call: func() error {
+				_, err := c.CustomerSearchWithResponse(ctx, &CustomerSearchParams{})
+				return err
+			},
+			expected: "id,name",
+		},
+		{
+			description: "by operation id",
+			call: func() error {
+				_, err := c.CustomerGetWithResponse(ctx, 1, &CustomerGetParams{})
+				return err
+			},
+			expected: "id,email",
+		},
+		{
+			description: "explicit fields win",
+			call: func() error {
+				f := "*"
+				_, err := c.CustomerSearchWithResponse(ctx, &CustomerSearchParams{Fields: &f})
+				return err
+			},
+			expected: "*",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			fields = nil
+			require.NoError(t, tt.call())
+			require.Equal(t, []string{tt.expected}, fields)
+		})
+	}
+}
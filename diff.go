@@ -0,0 +1,130 @@
+package tripletex
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+)
+
+// serverManagedFields are the top-level fields set by Tripletex, which
+// [Diff] ignores.
+var serverManagedFields = []string{"id", "version", "url", "changes", "displayName"}
+
+// Diff returns the dotted paths of the fields of desired that differ in
+// actual, eg. ["email", "postalAddress.city"], in sorted order. It's meant
+// for sync jobs to skip updates of entities that haven't changed.
+//
+// Only fields set in desired are compared, as the generated models leave
+// unset fields nil, so desired is the state to sync rather than a full
+// entity. Server managed fields, like id, version and changes, are ignored.
+// Lists are compared element by element, and differ if their lengths do.
+//
+// Returns error if desired or actual can't be encoded as JSON.
+func Diff[T any](desired, actual T) ([]string, error) {
+	d, err := toDiffValue(desired)
+	if err != nil {
+		return nil, fmt.Errorf("tripletex: diff: failed to encode desired %T: %w", desired, err)
+	}
+	a, err := toDiffValue(actual)
+	if err != nil {
+		return nil, fmt.Errorf("tripletex: diff: failed to encode actual %T: %w", actual, err)
+	}
+	if obj, ok := d.(map[string]any); ok {
+		for _, field := range serverManagedFields {
+			delete(obj, field)
+		}
+	}
+
+	var paths []string
+	diffValues("", d, a, &paths)
+	sort.Strings(paths)
+	return paths, nil
+}
+
+// Equal reports whether actual has the fields set in desired, see [Diff].
+// Values that can't be encoded as JSON are never equal.
+func Equal[T any](desired, actual T) bool {
+	paths, err := Diff(desired, actual)
+	return err == nil && len(paths) == 0
+}
+
+// toDiffValue returns v decoded from JSON into maps, slices and json.Number.
+func toDiffValue(v any) (any, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	var out any
+	if err = dec.Decode(&out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// diffValues appends the paths under path where the set fields of desired
+// differ from actual to paths.
+func diffValues(path string, desired, actual any, paths *[]string) {
+	switch d := desired.(type) {
+	case map[string]any:
+		a, ok := actual.(map[string]any)
+		if !ok {
+			*paths = append(*paths, orRoot(path))
+			return
+		}
+		for key, dv := range d {
+			diffValues(joinPath(path, key), dv, a[key], paths)
+		}
+	case []any:
+		a, ok := actual.([]any)
+		if !ok || len(a) != len(d) {
+			*paths = append(*paths, orRoot(path))
+			return
+		}
+		before := len(*paths)
+		for i := range d {
+			var elemPaths []string
+			diffValues(path, d[i], a[i], &elemPaths)
+			*paths = append(*paths, elemPaths...)
+		}
+		// Report each differing field of the list once
+		*paths = append((*paths)[:before], uniqueStrings((*paths)[before:])...)
+	case json.Number:
+		a, ok := actual.(json.Number)
+		if !ok {
+			*paths = append(*paths, orRoot(path))
+			return
+		}
+		df, derr := d.Float64()
+		af, aerr := a.Float64()
+		if derr != nil || aerr != nil || df != af {
+			*paths = append(*paths, orRoot(path))
+		}
+	default:
+		if desired != actual {
+			*paths = append(*paths, orRoot(path))
+		}
+	}
+}
+
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+func orRoot(path string) string {
+	if path == "" {
+		return "."
+	}
+	return path
+}
+
+func uniqueStrings(s []string) []string {
+	slices.Sort(s)
+	return slices.Compact(s)
+}
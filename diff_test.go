@@ -0,0 +1,84 @@
+package tripletex
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiff(t *testing.T) {
+	actual := Customer{
+		Id:            ptr(int64(1)),
+		Version:       ptr(int32(3)),
+		Name:          ptr("Acme AS"),
+		Email:         ptr("post@acme.no"),
+		PostalAddress: &Address{City: ptr("Oslo"), PostalCode: ptr("0150")},
+		Category1:     &CustomerCategory{Id: ptr(int64(5))},
+	}
+
+	tests := []struct {
+		description string
+		desired     Customer
+		expected    []string
+	}{
+		{
+			description: "unset fields are ignored",
+			desired:     Customer{Name: ptr("Acme AS")},
+		},
+		{
+			description: "server managed fields are ignored",
+			desired:     Customer{Id: ptr(int64(2)), Version: ptr(int32(0)), Name: ptr("Acme AS")},
+		},
+		{
+			description: "changed fields",
+			desired:     Customer{Name: ptr("Acme AS"), Email: ptr("faktura@acme.no"), PhoneNumber: ptr("12345678")},
+			expected:    []string{"email", "phoneNumber"},
+		},
+		{
+			description: "nested fields",
+			desired:     Customer{PostalAddress: &Address{City: ptr("Bergen"), PostalCode: ptr("0150")}},
+			expected:    []string{"postalAddress.city"},
+		},
+		{
+			description: "nested ids are compared",
+			desired:     Customer{Category1: &CustomerCategory{Id: ptr(int64(6))}},
+			expected:    []string{"category1.id"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			require := require.New(t)
+			paths, err := Diff(tt.desired, actual)
+			require.NoError(err)
+			require.Equal(tt.expected, paths)
+			require.Equal(len(tt.expected) == 0, Equal(tt.desired, actual))
+		})
+	}
+}
+
+func TestDiffLists(t *testing.T) {
+	require := require.New(t)
+
+	actual := Order{OrderLines: &[]OrderLine{
+		{Id: ptr(int64(1)), Description: ptr("Consulting"), Count: ptr(float32(2))},
+		{Id: ptr(int64(2)), Description: ptr("Travel"), Count: ptr(float32(1))},
+	}}
+
+	paths, err := Diff(Order{OrderLines: &[]OrderLine{
+		{Description: ptr("Consulting"), Count: ptr(float32(2))},
+		{Description: ptr("Travel"), Count: ptr(float32(1))},
+	}}, actual)
+	require.NoError(err)
+	require.Empty(paths)
+
+	paths, err = Diff(Order{OrderLines: &[]OrderLine{
+		{Description: ptr("Consulting"), Count: ptr(float32(3))},
+		{Description: ptr("Hotel"), Count: ptr(float32(2))},
+	}}, actual)
+	require.NoError(err)
+	require.Equal([]string{"orderLines.count", "orderLines.description"}, paths)
+
+	paths, err = Diff(Order{OrderLines: &[]OrderLine{{Description: ptr("Consulting")}}}, actual)
+	require.NoError(err)
+	require.Equal([]string{"orderLines"}, paths)
+}
@@ -0,0 +1,45 @@
+package tripletex
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// Do sends a request to path, relative to the base URL, eg. "/customer/1",
+// for endpoints missing from the generated client, eg. ones added after the
+// bundled specification.
+//
+// The request is authenticated and sent through the same http.Client as the
+// generated methods, with their retries, logging and validation. body is sent
+// as JSON if not nil.
+//
+// The response is returned as is, whatever its status, and its body must be
+// closed by the caller. Pass it and its body to [CheckResponse] to get an
+// [*APIError] for unsuccessful statuses.
+func (c *TripletexClient) Do(ctx context.Context, method, path string, query url.Values, body io.Reader) (*http.Response, error) {
+	u := strings.TrimSuffix(c.baseURL, "/") + "/" + strings.TrimPrefix(path, "/")
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+	req, err := http.NewRequestWithContext(ctx, method, u, body)
+	if err != nil {
+		return nil, fmt.Errorf("tripletex: do: failed to create request: %w", err)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", jsonContentType)
+	}
+	req.Header.Set("Accept", "application/json")
+	if err = c.interceptAuth(ctx, req); err != nil {
+		return nil, fmt.Errorf("tripletex: do: %w", err)
+	}
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("tripletex: do: %s %s: %w", method, path, err)
+	}
+	return res, nil
+}
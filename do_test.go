@@ -0,0 +1,52 @@
+package tripletex
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDo(t *testing.T) {
+	require := require.New(t)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /unreleased/{id}/:action", func(w http.ResponseWriter, r *http.Request) {
+		_, password, ok := r.BasicAuth()
+		require.True(ok, "should authenticate")
+		require.NotEmpty(password)
+		require.Equal("7", r.PathValue("id"))
+		require.Equal("true", r.URL.Query().Get("dryRun"))
+		require.Equal(jsonContentType, r.Header.Get("Content-Type"))
+		body, err := io.ReadAll(r.Body)
+		require.NoError(err)
+		require.Equal(`{"a":1}`, string(body))
+		writeTestJSON(w, http.StatusOK, map[string]any{"value": map[string]any{"id": 7}})
+	})
+	mux.HandleFunc("GET /unreleased/missing", func(w http.ResponseWriter, r *http.Request) {
+		writeTestJSON(w, http.StatusNotFound, APIError{Status: http.StatusNotFound, Message: "Not found"})
+	})
+	c := newTestClient(t, mux)
+	ctx := context.Background()
+
+	res, err := c.Do(ctx, http.MethodPost, "/unreleased/7/:action", url.Values{"dryRun": {"true"}}, strings.NewReader(`{"a":1}`))
+	require.NoError(err)
+	body, err := io.ReadAll(res.Body)
+	res.Body.Close()
+	require.NoError(err)
+	require.NoError(CheckResponse(res, body))
+	require.JSONEq(`{"value":{"id":7}}`, string(body))
+
+	res, err = c.Do(ctx, http.MethodGet, "unreleased/missing", nil, nil)
+	require.NoError(err)
+	body, err = io.ReadAll(res.Body)
+	res.Body.Close()
+	require.NoError(err)
+	var apiErr *APIError
+	require.ErrorAs(CheckResponse(res, body), &apiErr)
+	require.Equal(http.StatusNotFound, apiErr.Status)
+}
@@ -0,0 +1,74 @@
+package tripletex
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+)
+
+// SchemaDrift is a response not matching the OpenAPI specification.
+type SchemaDrift struct {
+	Method string
+	Path   string
+	Status int
+	Err    error // The mismatches
+}
+
+// WithSchemaDriftHandler makes the client validate successful responses
+// against spec, eg. spec.Bundled(), and call fn with the mismatches found, eg.
+// to log or alert on changes Tripletex made ahead of the published
+// specification.
+//
+// Responses are returned as is, whether they match or not. fn is called
+// before the response is returned, so it should be quick.
+func WithSchemaDriftHandler(spec Spec, fn func(drift SchemaDrift)) Option {
+	return func(tc *TripletexClient) {
+		tc.driftSpec = spec
+		tc.onSchemaDrift = fn
+	}
+}
+
+// driftTransport validates responses of next, reporting mismatches to
+// onDrift.
+type driftTransport struct {
+	next    http.RoundTripper
+	spec    Spec
+	baseURL string
+	onDrift func(drift SchemaDrift)
+}
+
+// withDriftTransport returns a copy of client validating its responses
+// against spec.
+func withDriftTransport(client *http.Client, spec Spec, baseURL string, onDrift func(drift SchemaDrift)) *http.Client {
+	next := client.Transport
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	c := *client
+	c.Transport = &driftTransport{next: next, spec: spec, baseURL: baseURL, onDrift: onDrift}
+	return &c
+}
+
+func (t *driftTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	res, err := t.next.RoundTrip(req)
+	if err != nil || res.StatusCode < 200 || res.StatusCode >= 300 {
+		return res, err
+	}
+
+	body, err := io.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	res.Body = io.NopCloser(bytes.NewReader(body))
+
+	if err = t.spec.ValidateResponse(req, res, body, t.baseURL); err != nil {
+		t.onDrift(SchemaDrift{
+			Method: req.Method,
+			Path:   req.URL.Path,
+			Status: res.StatusCode,
+			Err:    err,
+		})
+	}
+	return res, nil
+}
@@ -0,0 +1,41 @@
+package tripletex
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/valuetechdev/tripletex-go/spec"
+)
+
+func TestSchemaDriftHandler(t *testing.T) {
+	require := require.New(t)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /customer/{id}", func(w http.ResponseWriter, r *http.Request) {
+		language := "NO"
+		if r.PathValue("id") == "2" {
+			language = "SV"
+		}
+		writeTestJSON(w, http.StatusOK, map[string]any{"value": map[string]any{"id": 1, "language": language}})
+	})
+	var drifts []SchemaDrift
+	c := newTestClient(t, mux, WithSchemaDriftHandler(spec.Bundled(), func(drift SchemaDrift) {
+		drifts = append(drifts, drift)
+	}))
+	ctx := context.Background()
+
+	res, err := c.CustomerGetWithResponse(ctx, 1, &CustomerGetParams{})
+	require.NoError(err)
+	require.NoError(checkResponse(res.HTTPResponse, res.Body))
+	require.Empty(drifts)
+
+	res, err = c.CustomerGetWithResponse(ctx, 2, &CustomerGetParams{})
+	require.NoError(err)
+	require.Equal("SV", string(*res.JSONDefault.Value.Language))
+	require.Len(drifts, 1)
+	require.Equal(http.MethodGet, drifts[0].Method)
+	require.Equal("/customer/2", drifts[0].Path)
+	require.ErrorContains(drifts[0].Err, "language")
+}
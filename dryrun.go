@@ -0,0 +1,75 @@
+package tripletex
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// ErrDryRun is returned for valid requests by a client created with
+// [WithDryRun], as they are not sent.
+var ErrDryRun = errors.New("tripletex: dry run: request not sent")
+
+// RequestValidationError is returned by a client created with [WithDryRun]
+// for requests not matching the OpenAPI specification.
+type RequestValidationError struct {
+	Method string
+	Path   string
+	Err    error
+}
+
+func (e *RequestValidationError) Error() string {
+	return fmt.Sprintf("tripletex: dry run: invalid request %s %s: %v", e.Method, e.Path, e.Err)
+}
+
+func (e *RequestValidationError) Unwrap() error {
+	return e.Err
+}
+
+// WithDryRun makes the client validate requests against spec, eg.
+// spec.Bundled(), instead of sending them, eg. to check generated payloads in
+// CI without a Tripletex account.
+//
+// Requests fail with a [*RequestValidationError] if invalid, and with
+// [ErrDryRun] if valid. No session token is created.
+func WithDryRun(spec Spec) Option {
+	return func(tc *TripletexClient) {
+		tc.dryRun = spec
+	}
+}
+
+// dryRunTransport validates requests instead of sending them.
+type dryRunTransport struct {
+	spec    Spec
+	baseURL string
+}
+
+func (t *dryRunTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := validateRequest(t.spec, req, t.baseURL); err != nil {
+		return nil, err
+	}
+	return nil, ErrDryRun
+}
+
+// validateRequest validates req, sent to baseURL, against spec. Closes the
+// body of req.
+func validateRequest(spec Spec, req *http.Request, baseURL string) error {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return fmt.Errorf("tripletex: dry run: failed to read body: %w", err)
+		}
+	}
+
+	req = req.Clone(req.Context())
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	if err := spec.ValidateRequest(req, baseURL); err != nil {
+		return &RequestValidationError{Method: req.Method, Path: req.URL.Path, Err: err}
+	}
+	return nil
+}
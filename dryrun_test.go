@@ -0,0 +1,65 @@
+package tripletex
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/valuetechdev/tripletex-go/spec"
+)
+
+func TestDryRun(t *testing.T) {
+	c := New(Credentials{}, WithBaseURLOption("http://127.0.0.1:1/v2"), WithDryRun(spec.Bundled()))
+	ctx := context.Background()
+
+	tests := []struct {
+		description string
+		do          func() error
+		wantValid   bool
+	}{
+		{
+			description: "valid body",
+			do: func() error {
+				_, err := c.CustomerPostWithApplicationJSONCharsetUTF8BodyWithResponse(ctx, Customer{Name: ptr("Acme")})
+				return err
+			},
+			wantValid: true,
+		},
+		{
+			description: "valid parameters",
+			do: func() error {
+				_, err := c.CustomerSearchWithResponse(ctx, &CustomerSearchParams{From: ptr(0), Count: ptr(10)})
+				return err
+			},
+			wantValid: true,
+		},
+		{
+			description: "invalid body",
+			do: func() error {
+				_, err := c.CustomerPostWithBodyWithResponse(ctx, jsonContentType, strings.NewReader(`{"name":5}`))
+				return err
+			},
+		},
+		{
+			description: "invalid enum",
+			do: func() error {
+				_, err := c.CustomerPostWithBodyWithResponse(ctx, jsonContentType, strings.NewReader(`{"name":"Acme","language":"XX"}`))
+				return err
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			require := require.New(t)
+
+			err := tt.do()
+			if tt.wantValid {
+				require.ErrorIs(err, ErrDryRun)
+				return
+			}
+			var validationErr *RequestValidationError
+			require.ErrorAs(err, &validationErr)
+		})
+	}
+}
@@ -0,0 +1,153 @@
+package tripletex
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/valuetechdev/tripletex-go/fields"
+)
+
+// peppolDirectoryURL is the search API of the public Peppol Directory.
+const peppolDirectoryURL = "https://directory.peppol.eu/search/1.0/json"
+
+// ErrNoSendMethod is returned by [TripletexClient.SendInvoice] when the
+// customer can't receive EHF and has no email address.
+var ErrNoSendMethod = errors.New("tripletex: invoice: customer has no way to receive the invoice")
+
+// EHFLookup reports whether the organization with organizationNumber can
+// receive EHF invoices over Peppol.
+type EHFLookup func(ctx context.Context, organizationNumber string) (bool, error)
+
+// PeppolDirectoryLookup returns an [EHFLookup] searching the public Peppol
+// Directory with client, or [http.DefaultClient] if nil.
+//
+// Tripletex has no lookup endpoint of its own. Organizations registered in
+// the directory with a Norwegian organization number (scheme 0192) are
+// considered able to receive EHF.
+func PeppolDirectoryLookup(client *http.Client) EHFLookup {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return func(ctx context.Context, organizationNumber string) (bool, error) {
+		q := url.Values{"participant": {"iso6523-actorid-upis::0192:" + organizationNumber}}
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, peppolDirectoryURL+"?"+q.Encode(), nil)
+		if err != nil {
+			return false, fmt.Errorf("tripletex: peppol: failed to create request: %w", err)
+		}
+		res, err := client.Do(req)
+		if err != nil {
+			return false, fmt.Errorf("tripletex: peppol: failed to look up %s: %w", organizationNumber, err)
+		}
+		defer res.Body.Close()
+		if res.StatusCode != http.StatusOK {
+			return false, fmt.Errorf("tripletex: peppol: failed to look up %s: %s", organizationNumber, res.Status)
+		}
+
+		var result struct {
+			TotalResultCount int `json:"total-result-count"`
+		}
+		if err = json.NewDecoder(res.Body).Decode(&result); err != nil {
+			return false, fmt.Errorf("tripletex: peppol: failed to decode lookup of %s: %w", organizationNumber, err)
+		}
+		return result.TotalResultCount > 0, nil
+	}
+}
+
+// SendInvoiceOptions configures [TripletexClient.SendInvoice].
+type SendInvoiceOptions struct {
+	Lookup EHFLookup // Defaults to [PeppolDirectoryLookup] with http.DefaultClient
+	Email  string    // Optional, overrides the customer's email address
+}
+
+// SendInvoice sends the invoice with id invoiceId to its customer with the
+// best available method, and returns the method used.
+//
+// The invoice is sent as EHF if the customer is a business whose organization
+// number can receive EHF, as reported by opts.Lookup. Otherwise, or if the
+// lookup or sending EHF fails, it's sent by email to opts.Email, the
+// customer's invoice email or the customer's email, in that order. Returns
+// [ErrNoSendMethod], joined with any lookup or EHF error, if there is no email
+// address to fall back to.
+func (c *TripletexClient) SendInvoice(ctx context.Context, invoiceId int64, opts SendInvoiceOptions) (InvoiceSendSendParamsSendType, error) {
+	lookup := opts.Lookup
+	if lookup == nil {
+		lookup = PeppolDirectoryLookup(nil)
+	}
+
+	f := fields.Builder.New().
+		Add("id").
+		Group("customer", "id", "organizationNumber", "isPrivateIndividual", "invoiceEmail", "email").
+		String()
+	invoiceRes, err := c.InvoiceGetWithResponse(ctx, invoiceId, &InvoiceGetParams{Fields: &f})
+	if err != nil {
+		return "", fmt.Errorf("tripletex: invoice: failed to get invoice %d: %w", invoiceId, err)
+	}
+	if err = checkResponse(invoiceRes.HTTPResponse, invoiceRes.Body); err != nil {
+		return "", fmt.Errorf("tripletex: invoice: failed to get invoice %d: %w", invoiceId, err)
+	}
+	if invoiceRes.JSONDefault == nil || invoiceRes.JSONDefault.Value == nil || invoiceRes.JSONDefault.Value.Customer == nil {
+		return "", fmt.Errorf("tripletex: invoice: invoice %d has no customer", invoiceId)
+	}
+	customer := invoiceRes.JSONDefault.Value.Customer
+
+	var ehfErr error
+	if orgNumber := organizationNumber(customer); orgNumber != "" {
+		canReceive, err := lookup(ctx, orgNumber)
+		if err != nil {
+			ehfErr = err
+		} else if canReceive {
+			if ehfErr = c.sendInvoice(ctx, invoiceId, InvoiceSendSendParamsSendTypeEHF, nil); ehfErr == nil {
+				return InvoiceSendSendParamsSendTypeEHF, nil
+			}
+		}
+	}
+
+	email := opts.Email
+	if email == "" && customer.InvoiceEmail != nil {
+		email = *customer.InvoiceEmail
+	}
+	if email == "" && customer.Email != nil {
+		email = *customer.Email
+	}
+	if email == "" {
+		if ehfErr != nil {
+			return "", errors.Join(ehfErr, ErrNoSendMethod)
+		}
+		return "", fmt.Errorf("%w: invoice %d", ErrNoSendMethod, invoiceId)
+	}
+	if err = c.sendInvoice(ctx, invoiceId, InvoiceSendSendParamsSendTypeEMAIL, &email); err != nil {
+		return "", errors.Join(ehfErr, err)
+	}
+	return InvoiceSendSendParamsSendTypeEMAIL, nil
+}
+
+func (c *TripletexClient) sendInvoice(ctx context.Context, invoiceId int64, sendType InvoiceSendSendParamsSendType, email *string) error {
+	res, err := c.InvoiceSendSendWithResponse(ctx, invoiceId, &InvoiceSendSendParams{
+		SendType:             sendType,
+		OverrideEmailAddress: email,
+	})
+	if err != nil {
+		return fmt.Errorf("tripletex: invoice: failed to send %d as %s: %w", invoiceId, sendType, err)
+	}
+	if err = checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return fmt.Errorf("tripletex: invoice: failed to send %d as %s: %w", invoiceId, sendType, err)
+	}
+	return nil
+}
+
+// organizationNumber returns the organization number of customer without
+// spaces, or "" if customer is a private individual or has none.
+func organizationNumber(customer *Customer) string {
+	if customer.IsPrivateIndividual != nil && *customer.IsPrivateIndividual {
+		return ""
+	}
+	if customer.OrganizationNumber == nil {
+		return ""
+	}
+	return strings.ReplaceAll(*customer.OrganizationNumber, " ", "")
+}
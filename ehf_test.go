@@ -0,0 +1,153 @@
+package tripletex
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var errTestLookup = errors.New("lookup failed")
+
+func TestSendInvoice(t *testing.T) {
+	tests := []struct {
+		description string
+		customer    Customer
+		canReceive  bool
+		lookupErr   error
+		ehfFails    bool
+		want        InvoiceSendSendParamsSendType
+		wantEmail   string
+		wantErr     error
+	}{
+		{
+			description: "ehf",
+			customer:    Customer{OrganizationNumber: ptr("923 609 016"), InvoiceEmail: ptr("faktura@acme.no")},
+			canReceive:  true,
+			want:        InvoiceSendSendParamsSendTypeEHF,
+		},
+		{
+			description: "email when not in peppol",
+			customer:    Customer{OrganizationNumber: ptr("923609016"), InvoiceEmail: ptr("faktura@acme.no"), Email: ptr("post@acme.no")},
+			want:        InvoiceSendSendParamsSendTypeEMAIL,
+			wantEmail:   "faktura@acme.no",
+		},
+		{
+			description: "email when ehf fails",
+			customer:    Customer{OrganizationNumber: ptr("923609016"), Email: ptr("post@acme.no")},
+			canReceive:  true,
+			ehfFails:    true,
+			want:        InvoiceSendSendParamsSendTypeEMAIL,
+			wantEmail:   "post@acme.no",
+		},
+		{
+			description: "email when lookup fails",
+			customer:    Customer{OrganizationNumber: ptr("923609016"), Email: ptr("post@acme.no")},
+			lookupErr:   errTestLookup,
+			want:        InvoiceSendSendParamsSendTypeEMAIL,
+			wantEmail:   "post@acme.no",
+		},
+		{
+			description: "email for private individuals",
+			customer:    Customer{IsPrivateIndividual: ptr(true), OrganizationNumber: ptr("923609016"), Email: ptr("ola@example.no")},
+			canReceive:  true,
+			want:        InvoiceSendSendParamsSendTypeEMAIL,
+			wantEmail:   "ola@example.no",
+		},
+		{
+			description: "no send method",
+			customer:    Customer{},
+			wantErr:     ErrNoSendMethod,
+		},
+		{
+			description: "no send method when lookup fails",
+			customer:    Customer{OrganizationNumber: ptr("923609016")},
+			lookupErr:   errTestLookup,
+			wantErr:     errTestLookup,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			require := require.New(t)
+
+			var sent []string
+			var sentEmail string
+			mux := http.NewServeMux()
+			mux.HandleFunc("GET /invoice/7", func(w http.ResponseWriter, r *http.Request) {
+				writeTestJSON(w, http.StatusOK, ResponseWrapperInvoice{Value: &Invoice{Id: ptr(int64(7)), Customer: &tt.customer}})
+			})
+			mux.HandleFunc("PUT /invoice/7/:send", func(w http.ResponseWriter, r *http.Request) {
+				sendType := r.URL.Query().Get("sendType")
+				sent = append(sent, sendType)
+				if sendType == "EHF" && tt.ehfFails {
+					writeTestJSON(w, http.StatusUnprocessableEntity, APIError{Status: 422, Message: "Recipient not found"})
+					return
+				}
+				sentEmail = r.URL.Query().Get("overrideEmailAddress")
+				w.WriteHeader(http.StatusNoContent)
+			})
+			c := newTestClient(t, mux)
+
+			var lookedUp string
+			sendType, err := c.SendInvoice(context.Background(), 7, SendInvoiceOptions{
+				Lookup: func(ctx context.Context, organizationNumber string) (bool, error) {
+					lookedUp = organizationNumber
+					return tt.canReceive, tt.lookupErr
+				},
+			})
+			if tt.wantErr != nil {
+				require.ErrorIs(err, tt.wantErr)
+				require.ErrorIs(err, ErrNoSendMethod)
+				require.Empty(sent)
+				return
+			}
+			require.NoError(err)
+			require.Equal(tt.want, sendType)
+			require.Equal(string(tt.want), sent[len(sent)-1])
+			require.Equal(tt.wantEmail, sentEmail)
+			if tt.customer.IsPrivateIndividual == nil {
+				require.Equal("923609016", lookedUp)
+			}
+		})
+	}
+}
+
+func TestPeppolDirectoryLookup(t *testing.T) {
+	require := require.New(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		count := 0
+		if r.URL.Query().Get("participant") == "iso6523-actorid-upis::0192:923609016" {
+			count = 1
+		}
+		writeTestJSON(w, http.StatusOK, map[string]any{"total-result-count": count})
+	}))
+	t.Cleanup(server.Close)
+	client := server.Client()
+	client.Transport = rewriteTransport{target: server.URL, next: client.Transport}
+
+	lookup := PeppolDirectoryLookup(client)
+	ok, err := lookup(context.Background(), "923609016")
+	require.NoError(err)
+	require.True(ok)
+	ok, err = lookup(context.Background(), "999999999")
+	require.NoError(err)
+	require.False(ok)
+}
+
+// rewriteTransport sends all requests to target.
+type rewriteTransport struct {
+	target string
+	next   http.RoundTripper
+}
+
+func (rt rewriteTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	target, err := http.NewRequestWithContext(req.Context(), req.Method, rt.target+req.URL.Path+"?"+req.URL.RawQuery, req.Body)
+	if err != nil {
+		return nil, err
+	}
+	return rt.next.RoundTrip(target)
+}
@@ -0,0 +1,185 @@
+package tripletex
+
+import (
+	"context"
+	"fmt"
+)
+
+// EntitlementTemplate is a predefined set of entitlements, eg.
+// EmployeeEntitlementGrantEntitlementsByTemplateGrantEntitlementsByTemplateParamsTemplateACCOUNTANT.
+type EntitlementTemplate = EmployeeEntitlementGrantEntitlementsByTemplateGrantEntitlementsByTemplateParamsTemplate
+
+// EmployeeService groups the employee, employment and entitlement endpoints.
+//
+// Use [TripletexClient.Employees] to get one.
+type EmployeeService struct {
+	client *TripletexClient
+}
+
+// Employees returns an [EmployeeService] using c.
+func (c *TripletexClient) Employees() *EmployeeService {
+	return &EmployeeService{client: c}
+}
+
+// Onboarding is the input to [EmployeeService.Onboard].
+type Onboarding struct {
+	Employee          Employee
+	Employment        Employment         // Employee is set by Onboard
+	EmploymentDetails *EmploymentDetails // Optional, Employment is set by Onboard
+	Template          EntitlementTemplate
+}
+
+// OnboardingStep is a step of [EmployeeService.Onboard].
+type OnboardingStep string
+
+const (
+	OnboardingStepEmployment        OnboardingStep = "employment"
+	OnboardingStepEmploymentDetails OnboardingStep = "employmentDetails"
+	OnboardingStepEntitlements      OnboardingStep = "entitlements"
+)
+
+// Onboarded is the result of [EmployeeService.Onboard].
+type Onboarded struct {
+	Employee          *Employee
+	Employment        *Employment
+	EmploymentDetails *EmploymentDetails
+}
+
+// OnboardingError is returned by [EmployeeService.Onboard] when a step fails
+// after the employee was created.
+//
+// The Tripletex API has no endpoints for deleting employees or employments,
+// so created resources can't be rolled back. Partial holds what was created
+// before Step failed, so the onboarding can be completed manually.
+type OnboardingError struct {
+	Step    OnboardingStep
+	Partial Onboarded
+	Err     error
+}
+
+func (e *OnboardingError) Error() string {
+	return fmt.Sprintf("tripletex: employee: onboarding failed at %s: %v", e.Step, e.Err)
+}
+
+func (e *OnboardingError) Unwrap() error {
+	return e.Err
+}
+
+// Onboard creates an employee with its employment, employment details and
+// entitlements.
+//
+// Input is validated before anything is created. If granting entitlements
+// fails, any entitlements granted are revoked. Other failures after the
+// employee is created are returned as [*OnboardingError].
+func (s *EmployeeService) Onboard(ctx context.Context, o Onboarding) (*Onboarded, error) {
+	if o.Employee.FirstName == nil || o.Employee.LastName == nil {
+		return nil, fmt.Errorf("tripletex: employee: onboarding requires first and last name")
+	}
+	if o.Employment.StartDate == nil {
+		return nil, fmt.Errorf("tripletex: employee: onboarding requires employment start date")
+	}
+	if o.Template == "" {
+		return nil, fmt.Errorf("tripletex: employee: onboarding requires entitlement template")
+	}
+
+	employee, err := s.Create(ctx, o.Employee)
+	if err != nil {
+		return nil, err
+	}
+	onboarded := &Onboarded{Employee: employee}
+
+	o.Employment.Employee = &Employee{Id: employee.Id}
+	employment, err := s.CreateEmployment(ctx, o.Employment)
+	if err != nil {
+		return nil, &OnboardingError{Step: OnboardingStepEmployment, Partial: *onboarded, Err: err}
+	}
+	onboarded.Employment = employment
+
+	if o.EmploymentDetails != nil {
+		o.EmploymentDetails.Employment = &Employment{Id: employment.Id}
+		details, err := s.CreateEmploymentDetails(ctx, *o.EmploymentDetails)
+		if err != nil {
+			return nil, &OnboardingError{Step: OnboardingStepEmploymentDetails, Partial: *onboarded, Err: err}
+		}
+		onboarded.EmploymentDetails = details
+	}
+
+	if err = s.GrantEntitlements(ctx, *employee.Id, o.Template); err != nil {
+		if revokeErr := s.RevokeEntitlements(ctx, *employee.Id); revokeErr != nil {
+			err = fmt.Errorf("%w (and failed to revoke entitlements: %w)", err, revokeErr)
+		}
+		return nil, &OnboardingError{Step: OnboardingStepEntitlements, Partial: *onboarded, Err: err}
+	}
+
+	return onboarded, nil
+}
+
+// Create creates employee.
+func (s *EmployeeService) Create(ctx context.Context, employee Employee) (*Employee, error) {
+	res, err := s.client.EmployeePostWithApplicationJSONCharsetUTF8BodyWithResponse(ctx, employee)
+	if err != nil {
+		return nil, fmt.Errorf("tripletex: employee: failed to create: %w", err)
+	}
+	if err = checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return nil, fmt.Errorf("tripletex: employee: failed to create: %w", err)
+	}
+	if res.JSONDefault == nil || res.JSONDefault.Value == nil || res.JSONDefault.Value.Id == nil {
+		return nil, fmt.Errorf("tripletex: employee: created employee is empty")
+	}
+	return res.JSONDefault.Value, nil
+}
+
+// CreateEmployment creates employment.
+func (s *EmployeeService) CreateEmployment(ctx context.Context, employment Employment) (*Employment, error) {
+	res, err := s.client.EmployeeEmploymentPostWithApplicationJSONCharsetUTF8BodyWithResponse(ctx, employment)
+	if err != nil {
+		return nil, fmt.Errorf("tripletex: employee: failed to create employment: %w", err)
+	}
+	if err = checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return nil, fmt.Errorf("tripletex: employee: failed to create employment: %w", err)
+	}
+	if res.JSONDefault == nil || res.JSONDefault.Value == nil || res.JSONDefault.Value.Id == nil {
+		return nil, fmt.Errorf("tripletex: employee: created employment is empty")
+	}
+	return res.JSONDefault.Value, nil
+}
+
+// CreateEmploymentDetails creates details.
+func (s *EmployeeService) CreateEmploymentDetails(ctx context.Context, details EmploymentDetails) (*EmploymentDetails, error) {
+	res, err := s.client.EmployeeEmploymentDetailsPostWithApplicationJSONCharsetUTF8BodyWithResponse(ctx, details)
+	if err != nil {
+		return nil, fmt.Errorf("tripletex: employee: failed to create employment details: %w", err)
+	}
+	if err = checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return nil, fmt.Errorf("tripletex: employee: failed to create employment details: %w", err)
+	}
+	if res.JSONDefault == nil || res.JSONDefault.Value == nil {
+		return nil, fmt.Errorf("tripletex: employee: created employment details is empty")
+	}
+	return res.JSONDefault.Value, nil
+}
+
+// GrantEntitlements grants the entitlements of template to the employee with
+// id employeeId.
+func (s *EmployeeService) GrantEntitlements(ctx context.Context, employeeId int64, template EntitlementTemplate) error {
+	res, err := s.client.EmployeeEntitlementGrantEntitlementsByTemplateGrantEntitlementsByTemplateWithResponse(ctx, &EmployeeEntitlementGrantEntitlementsByTemplateGrantEntitlementsByTemplateParams{
+		EmployeeId: employeeId,
+		Template:   template,
+	})
+	if err != nil {
+		return fmt.Errorf("tripletex: employee: failed to grant %s to %d: %w", template, employeeId, err)
+	}
+	if err = checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return fmt.Errorf("tripletex: employee: failed to grant %s to %d: %w", template, employeeId, err)
+	}
+	return nil
+}
+
+// RevokeEntitlements revokes all entitlements of the employee with id
+// employeeId.
+//
+// The Tripletex API only supports granting entitlements by template, so this
+// grants the NONE_PRIVILEGES template.
+func (s *EmployeeService) RevokeEntitlements(ctx context.Context, employeeId int64) error {
+	return s.GrantEntitlements(ctx, employeeId, EmployeeEntitlementGrantEntitlementsByTemplateGrantEntitlementsByTemplateParamsTemplateNONEPRIVILEGES)
+}
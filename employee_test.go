@@ -0,0 +1,96 @@
+package tripletex
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEmployeeOnboard(t *testing.T) {
+	tests := []struct {
+		description   string
+		grantStatus   int
+		expectedErr   bool
+		expectedStep  OnboardingStep
+		expectedGrant []string
+	}{
+		{
+			description:   "all steps succeed",
+			grantStatus:   http.StatusOK,
+			expectedGrant: []string{"ACCOUNTANT"},
+		},
+		{
+			description:   "grant fails and entitlements are revoked",
+			grantStatus:   http.StatusForbidden,
+			expectedErr:   true,
+			expectedStep:  OnboardingStepEntitlements,
+			expectedGrant: []string{"ACCOUNTANT", "NONE_PRIVILEGES"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			require := require.New(t)
+
+			var grants []string
+			mux := http.NewServeMux()
+			mux.HandleFunc("POST /employee", func(w http.ResponseWriter, r *http.Request) {
+				var employee Employee
+				require.NoError(json.NewDecoder(r.Body).Decode(&employee))
+				employee.Id = ptr(int64(7))
+				writeTestJSON(w, http.StatusCreated, ResponseWrapperEmployee{Value: &employee})
+			})
+			mux.HandleFunc("POST /employee/employment", func(w http.ResponseWriter, r *http.Request) {
+				var employment Employment
+				require.NoError(json.NewDecoder(r.Body).Decode(&employment))
+				require.Equal(int64(7), *employment.Employee.Id)
+				employment.Id = ptr(int64(8))
+				writeTestJSON(w, http.StatusCreated, ResponseWrapperEmployment{Value: &employment})
+			})
+			mux.HandleFunc("PUT /employee/entitlement/:grantEntitlementsByTemplate", func(w http.ResponseWriter, r *http.Request) {
+				require.Equal("7", r.URL.Query().Get("employeeId"))
+				template := r.URL.Query().Get("template")
+				grants = append(grants, template)
+				if template == "NONE_PRIVILEGES" {
+					w.WriteHeader(http.StatusOK)
+					return
+				}
+				w.WriteHeader(test.grantStatus)
+			})
+			c := newTestClient(t, mux)
+
+			onboarded, err := c.Employees().Onboard(context.Background(), Onboarding{
+				Employee:   Employee{FirstName: ptr("Kari"), LastName: ptr("Nordmann")},
+				Employment: Employment{StartDate: ptr("2025-01-01")},
+				Template:   EmployeeEntitlementGrantEntitlementsByTemplateGrantEntitlementsByTemplateParamsTemplateACCOUNTANT,
+			})
+			require.Equal(test.expectedGrant, grants)
+			if !test.expectedErr {
+				require.NoError(err)
+				require.Equal(int64(7), *onboarded.Employee.Id)
+				require.Equal(int64(8), *onboarded.Employment.Id)
+				return
+			}
+
+			var onboardingErr *OnboardingError
+			require.True(errors.As(err, &onboardingErr))
+			require.Equal(test.expectedStep, onboardingErr.Step)
+			require.Equal(int64(8), *onboardingErr.Partial.Employment.Id)
+			var apiErr *APIError
+			require.True(errors.As(err, &apiErr))
+			require.Equal(http.StatusForbidden, apiErr.Status)
+		})
+	}
+}
+
+func TestEmployeeOnboardValidation(t *testing.T) {
+	require := require.New(t)
+
+	c := newTestClient(t, http.NewServeMux())
+	_, err := c.Employees().Onboard(context.Background(), Onboarding{Employee: Employee{FirstName: ptr("Kari")}})
+	require.ErrorContains(err, "first and last name")
+}
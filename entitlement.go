@@ -0,0 +1,101 @@
+package tripletex
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// ErrMissingEntitlements is returned by [TripletexClient.RequireEntitlements]
+// when the employee of the session token lacks entitlements.
+var ErrMissingEntitlements = errors.New("tripletex: missing entitlements")
+
+// entitlementCache caches the entitlement names of a client.
+type entitlementCache struct {
+	mu    sync.Mutex
+	names map[string]bool
+}
+
+// HasEntitlement reports whether the employee of the session token has the
+// entitlement with name, eg. "User admin".
+//
+// Entitlements are those of the actual employee, the one the employee token
+// belongs to, as reported by [TripletexClient.WhoAmI]. They're fetched on
+// first use and cached for the lifetime of c.
+func (c *TripletexClient) HasEntitlement(ctx context.Context, name string) (bool, error) {
+	names, err := c.entitlementNames(ctx)
+	if err != nil {
+		return false, err
+	}
+	return names[name], nil
+}
+
+// RequireEntitlements returns [ErrMissingEntitlements] listing the names the
+// employee of the session token lacks, eg. to check permissions at startup
+// rather than failing in the middle of a sync. See
+// [TripletexClient.HasEntitlement].
+func (c *TripletexClient) RequireEntitlements(ctx context.Context, names ...string) error {
+	has, err := c.entitlementNames(ctx)
+	if err != nil {
+		return err
+	}
+	var missing []string
+	for _, name := range names {
+		if !has[name] {
+			missing = append(missing, fmt.Sprintf("%q", name))
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("%w: %s", ErrMissingEntitlements, strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// entitlementNames returns the set of entitlement names of the actual
+// employee, loading it on first use.
+func (c *TripletexClient) entitlementNames(ctx context.Context) (map[string]bool, error) {
+	whoAmI, err := c.WhoAmI(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	c.entitlements.mu.Lock()
+	defer c.entitlements.mu.Unlock()
+	if c.entitlements.names != nil {
+		return c.entitlements.names, nil
+	}
+
+	f := "id,name"
+	entitlements, err := collectPages(ctx, 0, func(ctx context.Context, from, count int) ([]Entitlement, PageInfo, error) {
+		res, err := c.EmployeeEntitlementSearchWithResponse(ctx, &EmployeeEntitlementSearchParams{
+			EmployeeId: &whoAmI.ActualEmployeeId,
+			Fields:     &f,
+			From:       &from,
+			Count:      &count,
+		})
+		if err != nil {
+			return nil, PageInfo{}, fmt.Errorf("tripletex: entitlement: failed to search entitlements of employee %d: %w", whoAmI.ActualEmployeeId, err)
+		}
+		if err = checkResponse(res.HTTPResponse, res.Body); err != nil {
+			return nil, PageInfo{}, fmt.Errorf("tripletex: entitlement: failed to search entitlements of employee %d: %w", whoAmI.ActualEmployeeId, err)
+		}
+		if res.JSONDefault == nil {
+			return nil, PageInfo{}, nil
+		}
+		return listValues(res.JSONDefault.Values), res.JSONDefault.PageInfo(), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	names := make(map[string]bool, len(entitlements))
+	for _, e := range entitlements {
+		if e.Name != nil {
+			names[*e.Name] = true
+		}
+	}
+	c.entitlements.names = names
+	return names, nil
+}
@@ -0,0 +1,62 @@
+package tripletex
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEntitlements(t *testing.T) {
+	require := require.New(t)
+
+	calls := 0
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /token/session/>whoAmI", func(w http.ResponseWriter, r *http.Request) {
+		writeTestJSON(w, http.StatusOK, ResponseWrapperLoggedInUserInfo{Value: &LoggedInUserInfo{
+			EmployeeId:       ptr(int32(1)),
+			ActualEmployeeId: ptr(int64(2)),
+		}})
+	})
+	mux.HandleFunc("GET /employee/entitlement", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		require.Equal("2", r.URL.Query().Get("employeeId"), "should use the actual employee")
+		writeTestJSON(w, http.StatusOK, ListResponseEntitlement{Values: &[]Entitlement{
+			{Name: ptr("User admin")},
+			{Name: ptr("Invoicing")},
+		}})
+	})
+	c := newTestClient(t, mux)
+	ctx := context.Background()
+
+	tests := []struct {
+		description string
+		names       []string
+		missing     string
+	}{
+		{description: "all present", names: []string{"User admin", "Invoicing"}},
+		{description: "none required"},
+		{description: "one missing", names: []string{"User admin", "Payroll"}, missing: `"Payroll"`},
+		{description: "several missing", names: []string{"Payroll", "Travel"}, missing: `"Payroll", "Travel"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			err := c.RequireEntitlements(ctx, tt.names...)
+			if tt.missing == "" {
+				require.NoError(err)
+				return
+			}
+			require.ErrorIs(err, ErrMissingEntitlements)
+			require.ErrorContains(err, tt.missing)
+		})
+	}
+
+	has, err := c.HasEntitlement(ctx, "Invoicing")
+	require.NoError(err)
+	require.True(has)
+	has, err = c.HasEntitlement(ctx, "Payroll")
+	require.NoError(err)
+	require.False(has)
+	require.Equal(1, calls, "should cache entitlements")
+}
@@ -0,0 +1,82 @@
+package tripletex
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// APIError is the error envelope Tripletex returns for unsuccessful requests.
+//
+// See the "Error/warning envelope" section of the Tripletex API documentation.
+type APIError struct {
+	Status             int                    `json:"status"`
+	Code               int                    `json:"code"`
+	Message            string                 `json:"message"`
+	Link               string                 `json:"link"`
+	DeveloperMessage   string                 `json:"developerMessage"`
+	ValidationMessages []ApiValidationMessage `json:"validationMessages"`
+	RequestId          string                 `json:"requestId"`
+	CorrelationId      string                 `json:"-"` // Sent with the request, see [CorrelationIdHeader]
+}
+
+func (e *APIError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "tripletex: api error: status %d", e.Status)
+	if e.Code != 0 {
+		fmt.Fprintf(&b, " (code %d)", e.Code)
+	}
+	if e.Message != "" {
+		fmt.Fprintf(&b, ": %s", e.Message)
+	}
+	for _, m := range e.ValidationMessages {
+		if m.Field != nil && m.Message != nil {
+			fmt.Fprintf(&b, "; %s: %s", *m.Field, *m.Message)
+		} else if m.Message != nil {
+			fmt.Fprintf(&b, "; %s", *m.Message)
+		}
+	}
+	if e.RequestId != "" {
+		fmt.Fprintf(&b, " [requestId=%s]", e.RequestId)
+	}
+	if e.CorrelationId != "" {
+		fmt.Fprintf(&b, " [correlationId=%s]", e.CorrelationId)
+	}
+	return b.String()
+}
+
+// CheckResponse returns an [*APIError] if res does not have a 2xx status,
+// decoded from its already read body, eg. for responses of
+// [TripletexClient.Do].
+func CheckResponse(res *http.Response, body []byte) error {
+	return checkResponse(res, body)
+}
+
+// checkResponse returns an [*APIError] if res does not have a 2xx status.
+//
+// body is the already read response body, as kept by the generated
+// *Response types.
+func checkResponse(res *http.Response, body []byte) error {
+	if res == nil {
+		return fmt.Errorf("tripletex: missing http response")
+	}
+	if res.StatusCode >= 200 && res.StatusCode < 300 {
+		return nil
+	}
+
+	apiErr := &APIError{}
+	if err := json.Unmarshal(body, apiErr); err != nil || apiErr.Status == 0 {
+		apiErr.Status = res.StatusCode
+	}
+	if apiErr.Message == "" && apiErr.DeveloperMessage == "" {
+		apiErr.Message = http.StatusText(res.StatusCode)
+	}
+	if apiErr.RequestId == "" {
+		apiErr.RequestId = res.Header.Get(requestIdHeader)
+	}
+	if res.Request != nil {
+		apiErr.CorrelationId = res.Request.Header.Get(CorrelationIdHeader)
+	}
+	return apiErr
+}
@@ -0,0 +1,157 @@
+package tripletex
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/valuetechdev/tripletex-go/fields"
+)
+
+// ExportFormat is the output format of [Export].
+type ExportFormat int
+
+const (
+	// ExportCSV writes a header row with the dotted paths of the fields, eg.
+	// "postalAddress.city", and a row per value.
+	ExportCSV ExportFormat = iota
+	// ExportNDJSON writes a JSON object per line, with the fields flattened
+	// to their dotted paths like [ExportCSV].
+	ExportNDJSON
+)
+
+// ErrExportFields is returned by [Export] if the field selection is empty or
+// contains wildcards, as the columns must be known up front.
+var ErrExportFields = errors.New("tripletex: export: fields must be explicit, without wildcards")
+
+// Export calls fetch with increasing offsets until a page without more values
+// after it is returned, as reported by its [PageInfo], and streams the values
+// to w in format.
+//
+// Values are flattened according to fieldSelection, eg.
+// "id,name,postalAddress(city)", which should also be passed as the fields
+// parameter of the search. Nested lists are written as JSON arrays, eg. the
+// path "orderLines.count" of an order becomes "[1,2]".
+//
+//	err := tripletex.Export(ctx, os.Stdout, tripletex.ExportCSV, "id,name", func(ctx context.Context, from, count int) ([]tripletex.Customer, tripletex.PageInfo, error) {
+//		res, err := c.CustomerSearchWithResponse(ctx, &tripletex.CustomerSearchParams{Fields: &f, From: &from, Count: &count})
+//		...
+//		return *res.JSONDefault.Values, res.JSONDefault.PageInfo(), nil
+//	})
+func Export[T any](ctx context.Context, w io.Writer, format ExportFormat, fieldSelection string, fetch func(ctx context.Context, from, count int) ([]T, PageInfo, error)) error {
+	paths, err := fields.Paths(fieldSelection)
+	if err != nil {
+		return fmt.Errorf("tripletex: export: %w", err)
+	}
+	if len(paths) == 0 || slices.ContainsFunc(paths, func(p string) bool { return strings.Contains(p, "*") }) {
+		return ErrExportFields
+	}
+
+	var write func(row []any) error
+	switch format {
+	case ExportCSV:
+		cw := csv.NewWriter(w)
+		defer cw.Flush()
+		if err = cw.Write(paths); err != nil {
+			return fmt.Errorf("tripletex: export: failed to write header: %w", err)
+		}
+		record := make([]string, len(paths))
+		write = func(row []any) error {
+			for i, v := range row {
+				record[i] = formatExportCell(v)
+			}
+			if err := cw.Write(record); err != nil {
+				return err
+			}
+			cw.Flush()
+			return cw.Error()
+		}
+	case ExportNDJSON:
+		enc := json.NewEncoder(w)
+		write = func(row []any) error {
+			obj := make(map[string]any, len(paths))
+			for i, path := range paths {
+				obj[path] = row[i]
+			}
+			return enc.Encode(obj)
+		}
+	default:
+		return fmt.Errorf("tripletex: export: unknown format %d", format)
+	}
+
+	return forEachPage(ctx, 0, fetch, func(page []T) error {
+		for _, v := range page {
+			row, err := flattenExport(v, paths)
+			if err != nil {
+				return err
+			}
+			if err = write(row); err != nil {
+				return fmt.Errorf("tripletex: export: failed to write: %w", err)
+			}
+		}
+		return nil
+	})
+}
+
+// flattenExport returns the values of v at paths.
+func flattenExport(v any, paths []string) ([]any, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("tripletex: export: failed to encode %T: %w", v, err)
+	}
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	var obj any
+	if err = dec.Decode(&obj); err != nil {
+		return nil, fmt.Errorf("tripletex: export: failed to decode %T: %w", v, err)
+	}
+
+	row := make([]any, len(paths))
+	for i, path := range paths {
+		row[i] = lookupPath(obj, strings.Split(path, "."))
+	}
+	return row, nil
+}
+
+// lookupPath returns the value at path in v, mapping over lists.
+func lookupPath(v any, path []string) any {
+	if len(path) == 0 {
+		return v
+	}
+	switch v := v.(type) {
+	case map[string]any:
+		return lookupPath(v[path[0]], path[1:])
+	case []any:
+		values := make([]any, len(v))
+		for i, item := range v {
+			values[i] = lookupPath(item, path)
+		}
+		return values
+	default:
+		return nil
+	}
+}
+
+// formatExportCell formats a value for a CSV cell, with lists and objects as
+// compact JSON.
+func formatExportCell(v any) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	case bool:
+		return fmt.Sprint(v)
+	default:
+		b, _ := json.Marshal(v)
+		return string(b)
+	}
+}
@@ -0,0 +1,62 @@
+package tripletex
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestExport(t *testing.T) {
+	orders := []Order{
+		{
+			Id:       ptr(int64(1)),
+			Customer: &Customer{Name: ptr("Acme, Inc.")},
+			OrderLines: &[]OrderLine{
+				{Count: ptr(float32(2))},
+				{Count: ptr(float32(1.5))},
+			},
+		},
+		{Id: ptr(int64(2)), IsClosed: ptr(true)},
+	}
+	fetch := func(ctx context.Context, from, count int) ([]Order, PageInfo, error) {
+		page := orders[min(from, len(orders)):min(from+count, len(orders))]
+		return page, PageInfo{From: from, Count: len(page), FullResultSize: len(orders), HasMore: from+len(page) < len(orders)}, nil
+	}
+	selection := "id,isClosed,customer(name),orderLines(count)"
+
+	tests := []struct {
+		description string
+		format      ExportFormat
+		want        string
+	}{
+		{
+			description: "csv",
+			format:      ExportCSV,
+			want: "id,isClosed,customer.name,orderLines.count\n" +
+				"1,,\"Acme, Inc.\",\"[2,1.5]\"\n" +
+				"2,true,,\n",
+		},
+		{
+			description: "ndjson",
+			format:      ExportNDJSON,
+			want: `{"customer.name":"Acme, Inc.","id":1,"isClosed":null,"orderLines.count":[2,1.5]}` + "\n" +
+				`{"customer.name":null,"id":2,"isClosed":true,"orderLines.count":null}` + "\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			require := require.New(t)
+
+			var buf bytes.Buffer
+			require.NoError(Export(context.Background(), &buf, tt.format, selection, fetch))
+			require.Equal(tt.want, buf.String())
+		})
+	}
+
+	t.Run("wildcard", func(t *testing.T) {
+		err := Export(context.Background(), &bytes.Buffer{}, ExportCSV, "*,customer(id)", fetch)
+		require.ErrorIs(t, err, ErrExportFields)
+	})
+}
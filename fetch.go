@@ -0,0 +1,66 @@
+package tripletex
+
+import (
+	"context"
+	"sync"
+)
+
+// FetchByID fetches the entities with ids using get, eg. a function calling
+// CustomerGetWithResponse, with at most concurrency calls in flight, or 4 if
+// concurrency <= 0. Duplicate ids are fetched once.
+//
+// Returns the fetched entities and the errors of the ids that failed, by id.
+// Ids not fetched before ctx is done fail with its error. Combine with
+// [WithThrottleRetries] to wait out rate limiting instead of failing.
+//
+//	customers, errs := tripletex.FetchByID(ctx, ids, func(ctx context.Context, id int64) (*tripletex.Customer, error) {
+//		res, err := c.CustomerGetWithResponse(ctx, id, &tripletex.CustomerGetParams{})
+//		...
+//		return res.JSONDefault.Value, nil
+//	}, 8)
+func FetchByID[T any](ctx context.Context, ids []int64, get func(ctx context.Context, id int64) (*T, error), concurrency int) (map[int64]*T, map[int64]error) {
+	if concurrency <= 0 {
+		concurrency = 4
+	}
+
+	var (
+		mu     sync.Mutex
+		values = make(map[int64]*T, len(ids))
+		errs   = make(map[int64]error)
+		seen   = make(map[int64]bool, len(ids))
+		wg     sync.WaitGroup
+		sem    = make(chan struct{}, concurrency)
+	)
+	for _, id := range ids {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+
+		select {
+		case <-ctx.Done():
+			mu.Lock()
+			errs[id] = ctx.Err()
+			mu.Unlock()
+			continue
+		case sem <- struct{}{}:
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			v, err := get(ctx, id)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				errs[id] = err
+				return
+			}
+			values[id] = v
+		}()
+	}
+	wg.Wait()
+	return values, errs
+}
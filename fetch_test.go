@@ -0,0 +1,55 @@
+package tripletex
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFetchByID(t *testing.T) {
+	require := require.New(t)
+
+	var inFlight, maxInFlight, calls atomic.Int32
+	errNotFound := errors.New("not found")
+	get := func(ctx context.Context, id int64) (*Customer, error) {
+		calls.Add(1)
+		n := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			m := maxInFlight.Load()
+			if n <= m || maxInFlight.CompareAndSwap(m, n) {
+				break
+			}
+		}
+		if id == 3 {
+			return nil, errNotFound
+		}
+		return &Customer{Id: &id}, nil
+	}
+
+	values, errs := FetchByID(context.Background(), []int64{1, 2, 3, 4, 5, 2}, get, 2)
+	require.Len(values, 4)
+	for _, id := range []int64{1, 2, 4, 5} {
+		require.Equal(id, *values[id].Id)
+	}
+	require.Equal(map[int64]error{3: errNotFound}, errs)
+	require.Equal(int32(5), calls.Load(), "should fetch duplicates once")
+	require.LessOrEqual(maxInFlight.Load(), int32(2))
+}
+
+func TestFetchByIDCanceled(t *testing.T) {
+	require := require.New(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	values, errs := FetchByID(ctx, []int64{1, 2}, func(ctx context.Context, id int64) (*Customer, error) {
+		return nil, ctx.Err()
+	}, 1)
+	require.Empty(values)
+	require.Len(errs, 2)
+	require.ErrorIs(errs[1], context.Canceled)
+	require.ErrorIs(errs[2], context.Canceled)
+}
@@ -184,3 +184,78 @@ func fieldsToString(input fields) string {
 	slices.Sort(s)
 	return strings.Join(s, ",")
 }
+
+// Paths parses a field string and returns the dotted path of each leaf
+// field, in the order they appear.
+//
+// Example:
+//
+//	paths, err := Paths("id,name,postalAddress(city,country(id))")
+//	// Result: [id name postalAddress.city postalAddress.country.id]
+//
+// Returns error if parentheses are unbalanced or a field name is empty.
+func Paths(s string) ([]string, error) {
+	if strings.TrimSpace(s) == "" {
+		return nil, nil
+	}
+	p := &pathParser{s: s}
+	paths, err := p.list(nil)
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(s) {
+		return nil, fmt.Errorf("fields: unbalanced parentheses in %q", s)
+	}
+	return paths, nil
+}
+
+// pathParser parses a field string for [Paths].
+type pathParser struct {
+	s   string
+	pos int
+}
+
+// list parses comma separated fields until a closing parenthesis or the end,
+// returning their paths prefixed with prefix.
+func (p *pathParser) list(prefix []string) ([]string, error) {
+	var paths []string
+	for {
+		start := p.pos
+		for p.pos < len(p.s) && !strings.ContainsRune("(),", rune(p.s[p.pos])) {
+			p.pos++
+		}
+		name := strings.TrimSpace(p.s[start:p.pos])
+		if name == "" {
+			return nil, fmt.Errorf("fields: empty field name at %d in %q", start, p.s)
+		}
+		path := append(slices.Clone(prefix), name)
+
+		if p.peek() == '(' {
+			p.pos++
+			nested, err := p.list(path)
+			if err != nil {
+				return nil, err
+			}
+			if p.peek() != ')' {
+				return nil, fmt.Errorf("fields: unbalanced parentheses in %q", p.s)
+			}
+			p.pos++
+			paths = append(paths, nested...)
+		} else {
+			paths = append(paths, strings.Join(path, "."))
+		}
+
+		if p.peek() != ',' {
+			return paths, nil
+		}
+		p.pos++
+	}
+}
+
+// peek returns the byte at the current position, or 0 at the end.
+func (p *pathParser) peek() byte {
+	if p.pos >= len(p.s) {
+		return 0
+	}
+	return p.s[p.pos]
+}
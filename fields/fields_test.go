@@ -38,3 +38,36 @@ func TestFieldsBuilder(t *testing.T) {
 		})
 	}
 }
+
+func TestPaths(t *testing.T) {
+	for _, tt := range []struct {
+		description string
+		input       string
+		expected    []string
+		wantErr     bool
+	}{
+		{description: "empty", input: "", expected: nil},
+		{description: "flat", input: "id, name", expected: []string{"id", "name"}},
+		{
+			description: "nested",
+			input:       "id,postalAddress(city,country(id,name)),name",
+			expected:    []string{"id", "postalAddress.city", "postalAddress.country.id", "postalAddress.country.name", "name"},
+		},
+		{description: "wildcard", input: "*,customer(*)", expected: []string{"*", "customer.*"}},
+		{description: "unclosed", input: "id,customer(name", wantErr: true},
+		{description: "unopened", input: "id),name", wantErr: true},
+		{description: "empty name", input: "id,,name", wantErr: true},
+		{description: "group without name", input: "(id)", wantErr: true},
+	} {
+		t.Run(tt.description, func(t *testing.T) {
+			require := require.New(t)
+			paths, err := Paths(tt.input)
+			if tt.wantErr {
+				require.Error(err)
+				return
+			}
+			require.NoError(err)
+			require.Equal(tt.expected, paths)
+		})
+	}
+}
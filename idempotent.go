@@ -0,0 +1,176 @@
+package tripletex
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// CreateCustomerOnce creates customer, or returns the existing customer with
+// the same organization number. Returns true if the customer was created.
+//
+// Like the other Create*Once methods, it makes creating safe to retry by
+// using a reference on the entity as idempotency key. Unlike
+// [TripletexClient.UpsertCustomerByOrgNumber], the existing customer is
+// returned unchanged.
+//
+// Returns error if customer has no organization number, or if multiple
+// customers share it.
+func (c *TripletexClient) CreateCustomerOnce(ctx context.Context, customer Customer) (*Customer, bool, error) {
+	if customer.OrganizationNumber == nil || *customer.OrganizationNumber == "" {
+		return nil, false, fmt.Errorf("tripletex: create: customer is missing organization number")
+	}
+	orgNumber := *customer.OrganizationNumber
+
+	return createOnce(ctx, customer, upsertOps[Customer]{
+		name: "customer",
+		find: func(ctx context.Context) ([]Customer, error) {
+			res, err := c.CustomerSearchWithResponse(ctx, &CustomerSearchParams{OrganizationNumber: &orgNumber})
+			if err != nil {
+				return nil, fmt.Errorf("tripletex: create: failed to search customers: %w", err)
+			}
+			if err = checkResponse(res.HTTPResponse, res.Body); err != nil {
+				return nil, fmt.Errorf("tripletex: create: failed to search customers: %w", err)
+			}
+			if res.JSONDefault == nil {
+				return nil, nil
+			}
+			return listValues(res.JSONDefault.Values), nil
+		},
+		create: func(ctx context.Context, v Customer) (*Customer, error) {
+			res, err := c.CustomerPostWithApplicationJSONCharsetUTF8BodyWithResponse(ctx, v)
+			if err != nil {
+				return nil, fmt.Errorf("tripletex: create: failed to create customer: %w", err)
+			}
+			if err = checkResponse(res.HTTPResponse, res.Body); err != nil {
+				return nil, fmt.Errorf("tripletex: create: failed to create customer: %w", err)
+			}
+			if res.JSONDefault == nil || res.JSONDefault.Value == nil {
+				return nil, fmt.Errorf("tripletex: create: created customer is empty")
+			}
+			return res.JSONDefault.Value, nil
+		},
+	})
+}
+
+// CreateOrderOnce creates order, or returns the existing order with the same
+// number and order date. Returns true if the order was created.
+//
+// Returns error if order has no number or order date, or if multiple orders
+// share them.
+func (c *TripletexClient) CreateOrderOnce(ctx context.Context, order Order) (*Order, bool, error) {
+	if order.Number == nil || *order.Number == "" {
+		return nil, false, fmt.Errorf("tripletex: create: order is missing number")
+	}
+	if order.OrderDate == nil {
+		return nil, false, fmt.Errorf("tripletex: create: order %s is missing order date", *order.Number)
+	}
+	number := *order.Number
+	dateFrom, dateTo, err := dayRange(*order.OrderDate)
+	if err != nil {
+		return nil, false, fmt.Errorf("tripletex: create: order %s: %w", number, err)
+	}
+
+	return createOnce(ctx, order, upsertOps[Order]{
+		name: "order",
+		find: func(ctx context.Context) ([]Order, error) {
+			res, err := c.OrderSearchWithResponse(ctx, &OrderSearchParams{
+				Number:        &number,
+				OrderDateFrom: dateFrom,
+				OrderDateTo:   dateTo,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("tripletex: create: failed to search orders: %w", err)
+			}
+			if err = checkResponse(res.HTTPResponse, res.Body); err != nil {
+				return nil, fmt.Errorf("tripletex: create: failed to search orders: %w", err)
+			}
+			if res.JSONDefault == nil {
+				return nil, nil
+			}
+			return listValues(res.JSONDefault.Values), nil
+		},
+		create: func(ctx context.Context, v Order) (*Order, error) {
+			res, err := c.OrderPostWithApplicationJSONCharsetUTF8BodyWithResponse(ctx, v)
+			if err != nil {
+				return nil, fmt.Errorf("tripletex: create: failed to create order %s: %w", number, err)
+			}
+			if err = checkResponse(res.HTTPResponse, res.Body); err != nil {
+				return nil, fmt.Errorf("tripletex: create: failed to create order %s: %w", number, err)
+			}
+			if res.JSONDefault == nil || res.JSONDefault.Value == nil {
+				return nil, fmt.Errorf("tripletex: create: created order %s is empty", number)
+			}
+			return res.JSONDefault.Value, nil
+		},
+	})
+}
+
+// CreateInvoiceOnce creates invoice, or returns the existing invoice with the
+// same invoice number and invoice date. Returns true if the invoice was
+// created.
+//
+// Returns error if invoice has no invoice number, as 0 or none makes
+// Tripletex generate one, or no invoice date, or if multiple invoices share
+// them.
+func (c *TripletexClient) CreateInvoiceOnce(ctx context.Context, invoice Invoice, params *InvoicePostParams) (*Invoice, bool, error) {
+	if invoice.InvoiceNumber == nil || *invoice.InvoiceNumber == 0 {
+		return nil, false, fmt.Errorf("tripletex: create: invoice is missing invoice number")
+	}
+	if invoice.InvoiceDate == nil {
+		return nil, false, fmt.Errorf("tripletex: create: invoice %d is missing invoice date", *invoice.InvoiceNumber)
+	}
+	number := *invoice.InvoiceNumber
+	numberParam := fmt.Sprint(number)
+	dateFrom, dateTo, err := dayRange(*invoice.InvoiceDate)
+	if err != nil {
+		return nil, false, fmt.Errorf("tripletex: create: invoice %d: %w", number, err)
+	}
+	if params == nil {
+		params = &InvoicePostParams{}
+	}
+
+	return createOnce(ctx, invoice, upsertOps[Invoice]{
+		name: "invoice",
+		find: func(ctx context.Context) ([]Invoice, error) {
+			res, err := c.InvoiceSearchWithResponse(ctx, &InvoiceSearchParams{
+				InvoiceNumber:   &numberParam,
+				InvoiceDateFrom: dateFrom,
+				InvoiceDateTo:   dateTo,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("tripletex: create: failed to search invoices: %w", err)
+			}
+			if err = checkResponse(res.HTTPResponse, res.Body); err != nil {
+				return nil, fmt.Errorf("tripletex: create: failed to search invoices: %w", err)
+			}
+			if res.JSONDefault == nil {
+				return nil, nil
+			}
+			return listValues(res.JSONDefault.Values), nil
+		},
+		create: func(ctx context.Context, v Invoice) (*Invoice, error) {
+			res, err := c.InvoicePostWithApplicationJSONCharsetUTF8BodyWithResponse(ctx, params, v)
+			if err != nil {
+				return nil, fmt.Errorf("tripletex: create: failed to create invoice %d: %w", number, err)
+			}
+			if err = checkResponse(res.HTTPResponse, res.Body); err != nil {
+				return nil, fmt.Errorf("tripletex: create: failed to create invoice %d: %w", number, err)
+			}
+			if res.JSONDefault == nil || res.JSONDefault.Value == nil {
+				return nil, fmt.Errorf("tripletex: create: created invoice %d is empty", number)
+			}
+			return res.JSONDefault.Value, nil
+		},
+	})
+}
+
+// dayRange returns the search range of date, eg. "2025-03-01" to
+// "2025-03-02", as date to parameters are exclusive.
+func dayRange(date string) (from, to string, err error) {
+	d, err := time.Parse(time.DateOnly, date)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid date %q: %w", date, err)
+	}
+	return date, d.AddDate(0, 0, 1).Format(time.DateOnly), nil
+}
@@ -0,0 +1,88 @@
+package tripletex
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateOrderOnce(t *testing.T) {
+	tests := []struct {
+		description     string
+		existing        []Order
+		expectedCreated bool
+		expectedId      int64
+		expectedErr     string
+	}{
+		{
+			description:     "creates missing order",
+			expectedCreated: true,
+			expectedId:      2,
+		},
+		{
+			description: "returns existing order",
+			existing:    []Order{{Id: ptr(int64(1)), Number: ptr("A-1")}},
+			expectedId:  1,
+		},
+		{
+			description: "fails on ambiguous number",
+			existing:    []Order{{Id: ptr(int64(1))}, {Id: ptr(int64(3))}},
+			expectedErr: "found 2 matching orders",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			require := require.New(t)
+
+			created := 0
+			mux := http.NewServeMux()
+			mux.HandleFunc("GET /order", func(w http.ResponseWriter, r *http.Request) {
+				q := r.URL.Query()
+				require.Equal("A-1", q.Get("number"))
+				require.Equal("2025-03-31", q.Get("orderDateFrom"))
+				require.Equal("2025-04-01", q.Get("orderDateTo"))
+				writeTestJSON(w, http.StatusOK, ListResponseOrder{Values: &tt.existing})
+			})
+			mux.HandleFunc("POST /order", func(w http.ResponseWriter, r *http.Request) {
+				created++
+				var order Order
+				require.NoError(json.NewDecoder(r.Body).Decode(&order))
+				order.Id = ptr(int64(2))
+				writeTestJSON(w, http.StatusCreated, ResponseWrapperOrder{Value: &order})
+			})
+			c := newTestClient(t, mux)
+
+			order, wasCreated, err := c.CreateOrderOnce(context.Background(), Order{
+				Number:    ptr("A-1"),
+				OrderDate: ptr("2025-03-31"),
+			})
+			if tt.expectedErr != "" {
+				require.ErrorContains(err, tt.expectedErr)
+				require.Zero(created)
+				return
+			}
+			require.NoError(err)
+			require.Equal(tt.expectedCreated, wasCreated)
+			require.Equal(tt.expectedId, *order.Id)
+			if !tt.expectedCreated {
+				require.Zero(created, "should not create existing order")
+			}
+		})
+	}
+}
+
+func TestCreateOnceMissingReference(t *testing.T) {
+	require := require.New(t)
+
+	c := newTestClient(t, http.NewServeMux())
+
+	_, _, err := c.CreateCustomerOnce(context.Background(), Customer{Name: ptr("New AS")})
+	require.ErrorContains(err, "missing organization number")
+	_, _, err = c.CreateOrderOnce(context.Background(), Order{Number: ptr("A-1")})
+	require.ErrorContains(err, "missing order date")
+	_, _, err = c.CreateInvoiceOnce(context.Background(), Invoice{InvoiceNumber: ptr(int32(0))}, nil)
+	require.ErrorContains(err, "missing invoice number")
+}
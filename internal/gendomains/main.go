@@ -0,0 +1,801 @@
+// Command gendomains generates the API client from spec/openapi.json, split
+// into a package per domain.
+//
+// The models are generated into api/models, and the client of each domain,
+// the first segment of the operation paths, eg. "customer" or "ledger", into
+// api/<domain>, importing the models. The domain clients alias the
+// RequestEditorFn and HttpRequestDoer of api/models, so the types are the same
+// in every package. The tripletex package composes the domain clients into
+// [tripletex.ClientWithResponses] and aliases their types, so it keeps the API
+// of a single generated package.
+//
+// Run it from the module root with go generate.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/importer"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"go/types"
+	"log"
+	"maps"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"strings"
+	"sync"
+	"text/template"
+)
+
+const (
+	modulePath = "github.com/valuetechdev/tripletex-go"
+	specPath   = "spec/openapi.json"
+	modelsDir  = "api/models"
+)
+
+// shared are the plumbing types declared in api/models, which the domain
+// packages alias instead of declaring their own.
+var shared = []string{
+	"HttpRequestDoer",
+	"RequestEditorFn",
+}
+
+// plumbing are the types generated into every domain package, which are not
+// aliased by the tripletex package.
+var plumbing = []string{
+	"ClientInterface",
+	"ClientOption",
+	"ClientWithResponses",
+	"ClientWithResponsesInterface",
+	"HttpRequestDoer",
+	"RequestEditorFn",
+	"WriteClient",
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("gendomains: ")
+	if err := run(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func run() error {
+	spec, domains, err := readSpec(specPath)
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.MkdirTemp("", "gendomains")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmp)
+	codegen := filepath.Join(tmp, "oapi-codegen")
+	if err = command("go", "build", "-modfile=go.tool.mod", "-o", codegen, "github.com/oapi-codegen/oapi-codegen/v2/cmd/oapi-codegen"); err != nil {
+		return err
+	}
+	overlay, err := filepath.Abs("overlay.yml")
+	if err != nil {
+		return err
+	}
+	templates, err := filepath.Abs("internal/gendomains/client-with-responses.tmpl")
+	if err != nil {
+		return err
+	}
+
+	old, err := filepath.Glob("api/*/client.gen.go")
+	if err != nil {
+		return err
+	}
+	for _, path := range old {
+		if err = os.Remove(path); err != nil {
+			return err
+		}
+	}
+
+	if err = os.MkdirAll(modelsDir, 0o755); err != nil {
+		return err
+	}
+	jobs := []job{{
+		name:   "models",
+		config: fmt.Sprintf(modelsConfig, filepath.Join(modelsDir, "models.gen.go"), overlay),
+		spec:   specPath,
+	}}
+	for _, d := range domains {
+		if err = os.MkdirAll(filepath.Join("api", d.Name), 0o755); err != nil {
+			return err
+		}
+		path := filepath.Join(tmp, d.Name+".json")
+		if err = writeDomainSpec(path, spec, d.Paths); err != nil {
+			return err
+		}
+		jobs = append(jobs, job{
+			name:   d.Name,
+			config: fmt.Sprintf(domainConfig, d.Name, filepath.Join("api", d.Name, "client.gen.go"), modulePath, modelsDir, templates, overlay),
+			spec:   path,
+		})
+	}
+	if err = generate(tmp, codegen, jobs); err != nil {
+		return err
+	}
+	imp := importer.ForCompiler(token.NewFileSet(), "source", nil)
+	for _, d := range domains {
+		if err = qualifyModels(filepath.Join("api", d.Name, "client.gen.go"), imp); err != nil {
+			return fmt.Errorf("%s: %w", d.Name, err)
+		}
+	}
+
+	models, err := exportedNames(filepath.Join(modelsDir, "models.gen.go"))
+	if err != nil {
+		return err
+	}
+	for i := range domains {
+		names, err := exportedNames(filepath.Join("api", domains[i].Name, "client.gen.go"))
+		if err != nil {
+			return err
+		}
+		names.Types = slices.DeleteFunc(names.Types, func(name string) bool { return slices.Contains(plumbing, name) })
+		domains[i].Types = names.Types
+		domains[i].Funcs = names.Funcs
+	}
+	for _, d := range domains {
+		renameParams(d.Funcs, domains)
+	}
+
+	if err = writeTemplate(filepath.Join(modelsDir, "page_info.gen.go"), pageInfoTemplate, models.ListResponses); err != nil {
+		return err
+	}
+	if err = writeTemplate("models.gen.go", modelsTemplate, models); err != nil {
+		return err
+	}
+	if err = writeTemplate("requests.gen.go", requestsTemplate, domains); err != nil {
+		return err
+	}
+	return writeTemplate("client.gen.go", clientTemplate, domains)
+}
+
+// domain is a package of generated operations.
+type domain struct {
+	Name  string
+	Paths []string
+	Types []string // Exported types, except plumbing
+	Funcs []function
+}
+
+// readSpec returns the top-level fields of the spec at path, and its paths
+// grouped by domain, sorted by name.
+func readSpec(path string) (map[string]json.RawMessage, []domain, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	var spec map[string]json.RawMessage
+	if err = json.Unmarshal(b, &spec); err != nil {
+		return nil, nil, fmt.Errorf("failed to decode %s: %w", path, err)
+	}
+	var paths map[string]json.RawMessage
+	if err = json.Unmarshal(spec["paths"], &paths); err != nil {
+		return nil, nil, fmt.Errorf("failed to decode paths of %s: %w", path, err)
+	}
+
+	byName := map[string]*domain{}
+	for path := range paths {
+		name := domainName(path)
+		if byName[name] == nil {
+			byName[name] = &domain{Name: name}
+		}
+		byName[name].Paths = append(byName[name].Paths, path)
+	}
+
+	domains := make([]domain, 0, len(byName))
+	for _, d := range byName {
+		slices.Sort(d.Paths)
+		domains = append(domains, *d)
+	}
+	slices.SortFunc(domains, func(a, b domain) int { return strings.Compare(a.Name, b.Name) })
+	return spec, domains, nil
+}
+
+// writeDomainSpec writes spec with only paths to path.
+func writeDomainSpec(path string, spec map[string]json.RawMessage, paths []string) error {
+	var all map[string]json.RawMessage
+	if err := json.Unmarshal(spec["paths"], &all); err != nil {
+		return err
+	}
+	domainPaths := make(map[string]json.RawMessage, len(paths))
+	for _, p := range paths {
+		domainPaths[p] = all[p]
+	}
+	b, err := json.Marshal(domainPaths)
+	if err != nil {
+		return err
+	}
+
+	domainSpec := maps.Clone(spec)
+	domainSpec["paths"] = b
+	if b, err = json.Marshal(domainSpec); err != nil {
+		return err
+	}
+	return os.WriteFile(path, b, 0o644)
+}
+
+// domainName returns the package name of the domain of path, its first
+// segment in lower case, eg. "travelexpense" for "/travelExpense/{id}".
+// Internal endpoints belong to the domain after "/internal".
+func domainName(path string) string {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	if segments[0] == "internal" && len(segments) > 1 {
+		segments = segments[1:]
+	}
+	return strings.ToLower(segments[0])
+}
+
+// job is a run of oapi-codegen.
+type job struct {
+	name   string
+	config string
+	spec   string // Path of the spec
+}
+
+// generate runs codegen with each of jobs in parallel.
+func generate(tmp, codegen string, jobs []job) error {
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		errs []error
+		sem  = make(chan struct{}, runtime.GOMAXPROCS(0))
+	)
+	for _, j := range jobs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			err := func() error {
+				path := filepath.Join(tmp, j.name+".yaml")
+				if err := os.WriteFile(path, []byte(j.config), 0o644); err != nil {
+					return err
+				}
+				return command(codegen, "-config", path, j.spec)
+			}()
+			if err != nil {
+				mu.Lock()
+				errs = append(errs, fmt.Errorf("%s: %w", j.name, err))
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	return errors.Join(errs...)
+}
+
+// command runs name with args, with its output sent to stderr.
+func command(name string, args ...string) error {
+	cmd := exec.Command(name, args...)
+	cmd.Stdout = os.Stderr
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("%s: %w", filepath.Base(name), err)
+	}
+	return nil
+}
+
+// edit replaces the source between start and end with text.
+type edit struct {
+	start, end int
+	text       string
+}
+
+// qualifyModels rewrites the domain client at path to import the models by
+// name instead of dot importing them, and replaces its declarations of the
+// shared types with aliases of those in the models. A package can't declare
+// a name it dot imports.
+//
+// The file is type checked with imp to find the references to the models.
+func qualifyModels(path string, imp types.Importer) error {
+	src, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, path, src, parser.ParseComments)
+	if err != nil {
+		return err
+	}
+	offset := func(pos token.Pos) int { return fset.Position(pos).Offset }
+
+	var edits []edit
+	for _, spec := range f.Imports {
+		if spec.Name != nil && spec.Name.Name == "." {
+			edits = append(edits, edit{offset(spec.Name.Pos()), offset(spec.Path.Pos()), ""})
+		}
+	}
+	// The shared types are dropped before type checking, so that references
+	// to them resolve to the models, and are left unqualified.
+	f.Decls = slices.DeleteFunc(f.Decls, func(decl ast.Decl) bool {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.TYPE || len(gen.Specs) != 1 {
+			return false
+		}
+		spec := gen.Specs[0].(*ast.TypeSpec)
+		if !slices.Contains(shared, spec.Name.Name) {
+			return false
+		}
+		edits = append(edits, edit{offset(spec.Name.End()), offset(spec.Type.End()), " = models." + spec.Name.Name})
+		return true
+	})
+
+	info := &types.Info{Uses: make(map[*ast.Ident]types.Object)}
+	conf := types.Config{Importer: imp}
+	if _, err = conf.Check(f.Name.Name, fset, []*ast.File{f}, info); err != nil {
+		return err
+	}
+	for id, obj := range info.Uses {
+		if obj.Pkg() == nil || obj.Pkg().Path() != modulePath+"/"+modelsDir || obj.Parent() != obj.Pkg().Scope() {
+			continue
+		}
+		if !slices.Contains(shared, id.Name) {
+			edits = append(edits, edit{offset(id.Pos()), offset(id.Pos()), "models."})
+		}
+	}
+
+	slices.SortFunc(edits, func(a, b edit) int { return b.start - a.start })
+	for _, e := range edits {
+		src = slices.Concat(src[:e.start], []byte(e.text), src[e.end:])
+	}
+	if src, err = format.Source(src); err != nil {
+		return fmt.Errorf("failed to format %s: %w", path, err)
+	}
+	return os.WriteFile(path, src, 0o644)
+}
+
+// names are the exported top-level names of a file.
+type names struct {
+	Types  []string
+	Consts []string
+	Funcs  []function // Request builders and response parsers
+
+	// ListResponses are the types of list responses, with from, count and
+	// fullResultSize fields, eg. ListResponseCustomer.
+	ListResponses []string
+}
+
+// function is a request builder, eg. NewCustomerSearchRequest, or a response
+// parser, eg. ParseCustomerSearchResponse, forwarded to by the tripletex
+// package.
+type function struct {
+	Name    string
+	Params  []param
+	Results string // Eg. "(*http.Request, error)"
+}
+
+// param is a parameter of a function.
+type param struct {
+	Name     string
+	Type     string // Eg. "...RequestEditorFn" if variadic
+	Variadic bool
+}
+
+// isForwarded reports whether the function with name is forwarded to.
+func isForwarded(name string) bool {
+	return strings.HasPrefix(name, "New") && strings.Contains(name, "Request") && name != "NewClient" && name != "NewClientWithResponses" ||
+		strings.HasPrefix(name, "Parse") && strings.HasSuffix(name, "Response")
+}
+
+// newFunction returns the function declared by decl, with the types of the
+// models unqualified, as the tripletex package aliases them.
+func newFunction(fset *token.FileSet, decl *ast.FuncDecl) (function, error) {
+	fn := function{Name: decl.Name.Name}
+	for _, field := range decl.Type.Params.List {
+		typ, err := typeString(fset, field.Type)
+		if err != nil {
+			return fn, err
+		}
+		_, variadic := field.Type.(*ast.Ellipsis)
+		for _, name := range field.Names {
+			fn.Params = append(fn.Params, param{Name: name.Name, Type: typ, Variadic: variadic})
+		}
+	}
+	var results []string
+	for _, field := range decl.Type.Results.List {
+		typ, err := typeString(fset, field.Type)
+		if err != nil {
+			return fn, err
+		}
+		results = append(results, typ)
+	}
+	fn.Results = "(" + strings.Join(results, ", ") + ")"
+	return fn, nil
+}
+
+// renameParams renames the parameters of funcs named like a domain, eg.
+// token, which would shadow its package in the tripletex package.
+func renameParams(funcs []function, domains []domain) {
+	for _, fn := range funcs {
+		for i, p := range fn.Params {
+			if slices.ContainsFunc(domains, func(d domain) bool { return d.Name == p.Name }) {
+				fn.Params[i].Name += "Param"
+			}
+		}
+	}
+}
+
+// typeString returns the source of typ, without the package name of the
+// models.
+func typeString(fset *token.FileSet, typ ast.Expr) (string, error) {
+	s, err := nodeString(fset, typ)
+	return strings.ReplaceAll(s, "models.", ""), err
+}
+
+// nodeString returns the source of node.
+func nodeString(fset *token.FileSet, node any) (string, error) {
+	var buf bytes.Buffer
+	if err := printer.Fprint(&buf, fset, node); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// exportedNames returns the exported types, constants, request builders and
+// response parsers declared in the Go file at path.
+func exportedNames(path string) (names, error) {
+	var n names
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, path, nil, parser.SkipObjectResolution)
+	if err != nil {
+		return n, err
+	}
+	for _, decl := range f.Decls {
+		if fn, ok := decl.(*ast.FuncDecl); ok {
+			if fn.Recv == nil && isForwarded(fn.Name.Name) {
+				f, err := newFunction(fset, fn)
+				if err != nil {
+					return n, err
+				}
+				n.Funcs = append(n.Funcs, f)
+			}
+			continue
+		}
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			switch spec := spec.(type) {
+			case *ast.TypeSpec:
+				if spec.Name.IsExported() {
+					n.Types = append(n.Types, spec.Name.Name)
+					if isListResponse(spec) {
+						n.ListResponses = append(n.ListResponses, spec.Name.Name)
+					}
+				}
+			case *ast.ValueSpec:
+				if gen.Tok != token.CONST {
+					continue
+				}
+				for _, name := range spec.Names {
+					if name.IsExported() {
+						n.Consts = append(n.Consts, name.Name)
+					}
+				}
+			}
+		}
+	}
+	return n, nil
+}
+
+// isListResponse reports whether spec declares a struct with the pagination
+// fields of a list response.
+func isListResponse(spec *ast.TypeSpec) bool {
+	st, ok := spec.Type.(*ast.StructType)
+	if !ok {
+		return false
+	}
+	var found int
+	for _, field := range st.Fields.List {
+		for _, name := range field.Names {
+			if slices.Contains([]string{"From", "Count", "FullResultSize"}, name.Name) {
+				found++
+			}
+		}
+	}
+	return found == 3
+}
+
+// writeTemplate executes text with data and writes it formatted to path.
+func writeTemplate(path, text string, data any) error {
+	var buf bytes.Buffer
+	if err := template.Must(template.New(path).Parse(text)).Execute(&buf, data); err != nil {
+		return err
+	}
+	b, err := format.Source(buf.Bytes())
+	if err != nil {
+		return fmt.Errorf("failed to format %s: %w", path, err)
+	}
+	return os.WriteFile(path, b, 0o644)
+}
+
+const modelsConfig = `package: models
+output: %s
+generate:
+  models: true
+output-options:
+  overlay:
+    path: %s
+`
+
+// domainConfig dot imports the models, as the generated client refers to
+// them unqualified, until rewritten by qualifyModels. The client type is
+// renamed, as Client is a model. The
+// overlay isn't strict, as its actions may target paths of other domains.
+const domainConfig = `package: %s
+output: %s
+generate:
+  client: true
+additional-imports:
+  - package: %s/%s
+    alias: .
+output-options:
+  client-type-name: WriteClient
+  user-templates:
+    client-with-responses.tmpl: %s
+  overlay:
+    path: %s
+    strict: false
+`
+
+const modelsTemplate = `// Code generated by internal/gendomains. DO NOT EDIT.
+
+package tripletex
+
+import "github.com/valuetechdev/tripletex-go/api/models"
+
+type (
+{{- range .Types}}
+	{{.}} = models.{{.}}
+{{- end}}
+)
+
+const (
+{{- range .Consts}}
+	{{.}} = models.{{.}}
+{{- end}}
+)
+`
+
+// pageInfoTemplate adds a PageInfo method to the list responses.
+const pageInfoTemplate = `// Code generated by internal/gendomains. DO NOT EDIT.
+
+package models
+
+import "github.com/valuetechdev/tripletex-go/paging"
+{{range .}}
+// PageInfo returns the pagination metadata of r.
+func (r {{.}}) PageInfo() paging.PageInfo {
+	return paging.NewPageInfo(r.From, r.Count, r.FullResultSize)
+}
+{{end}}`
+
+// requestsTemplate forwards to the request builders and response parsers of
+// the domains, which the tripletex package exported before the split.
+const requestsTemplate = `// Code generated by internal/gendomains. DO NOT EDIT.
+
+package tripletex
+
+import (
+	"io"
+	"net/http"
+{{range .}}
+	"github.com/valuetechdev/tripletex-go/api/{{.Name}}"
+{{- end}}
+)
+{{range .}}
+{{- $domain := .Name}}
+{{- range .Funcs}}
+// {{.Name}} calls [{{$domain}}.{{.Name}}].
+func {{.Name}}({{range $i, $p := .Params}}{{if $i}}, {{end}}{{$p.Name}} {{$p.Type}}{{end}}) {{.Results}} {
+	return {{$domain}}.{{.Name}}({{range $i, $p := .Params}}{{if $i}}, {{end}}{{$p.Name}}{{if $p.Variadic}}...{{end}}{{end}})
+}
+{{end}}
+{{- end}}`
+
+const clientTemplate = `// Code generated by internal/gendomains. DO NOT EDIT.
+
+package tripletex
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/valuetechdev/tripletex-go/api/models"
+{{range .}}
+	"github.com/valuetechdev/tripletex-go/api/{{.Name}}"
+{{- end}}
+)
+
+// RequestEditorFn is the function signature for the RequestEditor callback
+// function. It's the same type as the RequestEditorFn of every domain, eg.
+// [customer.RequestEditorFn].
+type RequestEditorFn = models.RequestEditorFn
+
+// HttpRequestDoer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer = models.HttpRequestDoer
+
+// WriteClient holds the options of the clients of the API domains, and
+// performs requests with all of them.
+//
+// Deprecated: Use [ClientWithResponses], or the client of a domain, eg.
+// [customer.WriteClient].
+type WriteClient struct {
+	// The endpoint of the server, eg. "https://tripletex.no/v2".
+	Server string
+
+	// Doer for performing requests, typically a *http.Client.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests before they are sent.
+	RequestEditors []RequestEditorFn
+
+	ClientInterface
+
+	codec models.JSONCodec
+}
+
+// ClientOption allows setting custom parameters during construction.
+type ClientOption func(*WriteClient) error
+
+// NewClient creates a new [WriteClient].
+//
+// Deprecated: Use [NewClientWithResponses].
+func NewClient(server string, opts ...ClientOption) (*WriteClient, error) {
+	c, err := newClient(server, opts)
+	if err != nil {
+		return nil, err
+	}
+	if c.ClientInterface, err = newClientWithResponses(c); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// newClient returns a [WriteClient] configured with opts, without the
+// clients of the API domains.
+func newClient(server string, opts []ClientOption) (*WriteClient, error) {
+	c := &WriteClient{Server: server}
+	for _, opt := range opts {
+		if err := opt(c); err != nil {
+			return nil, err
+		}
+	}
+	if c.Client == nil {
+		c.Client = &http.Client{}
+	}
+	return c, nil
+}
+
+// edit applies the request editors to req.
+func (c *WriteClient) edit(ctx context.Context, req *http.Request) error {
+	for _, fn := range c.RequestEditors {
+		if err := fn(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is automatically
+// created using http.Client.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *WriteClient) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *WriteClient) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// WithBaseURL overrides the server.
+//
+// Deprecated: Pass the base URL to [NewClientWithResponses].
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *WriteClient) error {
+		u, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = u.String()
+		return nil
+	}
+}
+
+// WithCodec sets the codec of JSON request and response bodies. Defaults to
+// encoding/json.
+func WithCodec(codec models.JSONCodec) ClientOption {
+	return func(c *WriteClient) error {
+		c.codec = codec
+		return nil
+	}
+}
+
+type (
+{{- range .}}
+	{{.Name}}Client = {{.Name}}.ClientWithResponses
+{{- end}}
+)
+
+// ClientWithResponses composes the clients of the API domains, eg.
+// [customer.ClientWithResponses].
+type ClientWithResponses struct {
+{{- range .}}
+	*{{.Name}}Client
+{{- end}}
+}
+
+// ClientInterface is the interface of the request methods of the API domains.
+type ClientInterface interface {
+{{- range .}}
+	{{.Name}}.ClientInterface
+{{- end}}
+}
+
+// ClientWithResponsesInterface is the interface of [ClientWithResponses].
+type ClientWithResponsesInterface interface {
+{{- range .}}
+	{{.Name}}.ClientWithResponsesInterface
+{{- end}}
+}
+
+// NewClientWithResponses creates a new [ClientWithResponses], with the
+// client of each API domain sharing the options.
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	o, err := newClient(server, opts)
+	if err != nil {
+		return nil, err
+	}
+	return newClientWithResponses(o)
+}
+
+// newClientWithResponses creates the clients of the API domains with the
+// options of o.
+func newClientWithResponses(o *WriteClient) (*ClientWithResponses, error) {
+	c := &ClientWithResponses{}
+	var err error
+{{- range .}}
+	if c.{{.Name}}Client, err = {{.Name}}.NewClientWithResponses(o.Server, {{.Name}}.WithHTTPClient(o.Client), {{.Name}}.WithRequestEditorFn(o.edit)); err != nil {
+		return nil, err
+	}
+	c.{{.Name}}Client.Codec = o.codec
+{{- end}}
+	return c, nil
+}
+
+type (
+{{- range .}}
+{{- $domain := .Name}}
+{{- range .Types}}
+	{{.}} = {{$domain}}.{{.}}
+{{- end}}
+{{- end}}
+)
+`
@@ -0,0 +1,248 @@
+package tripletex
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// InventoryService groups the inventory, inventory location and stocktaking
+// endpoints.
+//
+// Use [TripletexClient.Inventory] to get one.
+type InventoryService struct {
+	client *TripletexClient
+}
+
+// Inventory returns an [InventoryService] using c.
+func (c *TripletexClient) Inventory() *InventoryService {
+	return &InventoryService{client: c}
+}
+
+// StockLevel is the stock of a product across inventories (warehouses).
+type StockLevel struct {
+	ProductId   int64
+	Total       float32
+	ByInventory map[int64]float32 // Stock by inventory id
+}
+
+// StockCorrection sets the counted stock of a product in an inventory.
+type StockCorrection struct {
+	ProductId    int64
+	InventoryId  int64
+	LocationName string // Optional, the inventory location to correct
+	Count        float32
+	Comment      string // Optional
+}
+
+// StockLevels returns the stock of the products with productIds, aggregated
+// across inventories, by product id.
+//
+// Products without stock are not in the result, and no productIds gives an
+// empty result. Requires Logistics Basic.
+func (s *InventoryService) StockLevels(ctx context.Context, productIds ...int64) (map[int64]*StockLevel, error) {
+	levels := make(map[int64]*StockLevel)
+	if len(productIds) == 0 {
+		return levels, nil
+	}
+
+	ids := joinIds(productIds)
+	f := "product(id),inventory(id),stockOfGoods"
+	locations, err := collectPages(ctx, 0, func(ctx context.Context, from, count int) ([]ProductInventoryLocation, PageInfo, error) {
+		res, err := s.client.ProductInventoryLocationSearchWithResponse(ctx, &ProductInventoryLocationSearchParams{
+			ProductId: &ids,
+			From:      &from,
+			Count:     &count,
+			Fields:    &f,
+		})
+		if err != nil {
+			return nil, PageInfo{}, fmt.Errorf("tripletex: inventory: failed to search product inventory locations: %w", err)
+		}
+		if err = checkResponse(res.HTTPResponse, res.Body); err != nil {
+			return nil, PageInfo{}, fmt.Errorf("tripletex: inventory: failed to search product inventory locations: %w", err)
+		}
+		if res.JSONDefault == nil {
+			return nil, PageInfo{}, nil
+		}
+		return listValues(res.JSONDefault.Values), res.JSONDefault.PageInfo(), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, l := range locations {
+		if l.Product == nil || l.Product.Id == nil || l.StockOfGoods == nil {
+			continue
+		}
+		level, ok := levels[*l.Product.Id]
+		if !ok {
+			level = &StockLevel{ProductId: *l.Product.Id, ByInventory: make(map[int64]float32)}
+			levels[*l.Product.Id] = level
+		}
+		level.Total += *l.StockOfGoods
+		if l.Inventory != nil && l.Inventory.Id != nil {
+			level.ByInventory[*l.Inventory.Id] += *l.StockOfGoods
+		}
+	}
+	return levels, nil
+}
+
+// Location returns the active location with name in the inventory with id
+// inventoryId.
+//
+// Returns [ErrNotFound] if no location has name. Requires Logistics Basic.
+func (s *InventoryService) Location(ctx context.Context, inventoryId int64, name string) (*InventoryLocation, error) {
+	warehouse := strconv.FormatInt(inventoryId, 10)
+	inactive := false
+	res, err := s.client.InventoryLocationSearchWithResponse(ctx, &InventoryLocationSearchParams{
+		WarehouseId: &warehouse,
+		IsInactive:  &inactive,
+		Name:        &name,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("tripletex: inventory: failed to search location %q: %w", name, err)
+	}
+	if err = checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return nil, fmt.Errorf("tripletex: inventory: failed to search location %q: %w", name, err)
+	}
+	if res.JSONDefault != nil {
+		// The name filter is not guaranteed to be an exact match.
+		for _, l := range listValues(res.JSONDefault.Values) {
+			if l.Name != nil && *l.Name == name {
+				return &l, nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("%w: location %q in inventory %d", ErrNotFound, name, inventoryId)
+}
+
+// CorrectStock sets the stock of products by completing a stocktaking per
+// inventory in corrections, dated today (see [WithClock]).
+//
+// Locations are resolved by name before anything is created, so an unknown
+// location fails without side effects.
+func (s *InventoryService) CorrectStock(ctx context.Context, corrections []StockCorrection) ([]Stocktaking, error) {
+	type locationKey struct {
+		inventoryId int64
+		name        string
+	}
+	locations := make(map[locationKey]*InventoryLocation)
+	var inventoryIds []int64
+	byInventory := make(map[int64][]StockCorrection)
+	for _, c := range corrections {
+		if _, ok := byInventory[c.InventoryId]; !ok {
+			inventoryIds = append(inventoryIds, c.InventoryId)
+		}
+		byInventory[c.InventoryId] = append(byInventory[c.InventoryId], c)
+
+		key := locationKey{c.InventoryId, c.LocationName}
+		if _, ok := locations[key]; ok || c.LocationName == "" {
+			continue
+		}
+		location, err := s.Location(ctx, c.InventoryId, c.LocationName)
+		if err != nil {
+			return nil, err
+		}
+		locations[key] = location
+	}
+
+	date := s.client.now().Format(time.DateOnly)
+	counted := true
+	stocktakings := make([]Stocktaking, 0, len(inventoryIds))
+	for _, inventoryId := range inventoryIds {
+		stocktaking, err := s.createStocktaking(ctx, inventoryId, date)
+		if err != nil {
+			return stocktakings, err
+		}
+
+		for _, c := range byInventory[inventoryId] {
+			line := ProductLine{
+				Stocktaking: &Stocktaking{Id: stocktaking.Id},
+				Product:     &Product{Id: &c.ProductId},
+				Count:       &c.Count,
+				Counted:     &counted,
+			}
+			if location := locations[locationKey{inventoryId, c.LocationName}]; location != nil {
+				line.Location = &InventoryLocation{Id: location.Id}
+			}
+			if c.Comment != "" {
+				line.Comment = &c.Comment
+			}
+			if err = s.addProductLine(ctx, line); err != nil {
+				return stocktakings, err
+			}
+		}
+
+		completed, err := s.completeStocktaking(ctx, *stocktaking.Id)
+		if err != nil {
+			return stocktakings, err
+		}
+		stocktakings = append(stocktakings, *completed)
+	}
+	return stocktakings, nil
+}
+
+func (s *InventoryService) createStocktaking(ctx context.Context, inventoryId int64, date string) (*Stocktaking, error) {
+	typeOfStocktaking := InventoryStocktakingPostParamsTypeOfStocktakingNOPRODUCTS
+	res, err := s.client.InventoryStocktakingPostWithApplicationJSONCharsetUTF8BodyWithResponse(ctx, &InventoryStocktakingPostParams{
+		TypeOfStocktaking: &typeOfStocktaking,
+	}, Stocktaking{
+		Inventory: &Inventory{Id: &inventoryId},
+		Date:      &date,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("tripletex: inventory: failed to create stocktaking for %d: %w", inventoryId, err)
+	}
+	if err = checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return nil, fmt.Errorf("tripletex: inventory: failed to create stocktaking for %d: %w", inventoryId, err)
+	}
+	if res.JSONDefault == nil || res.JSONDefault.Value == nil || res.JSONDefault.Value.Id == nil {
+		return nil, fmt.Errorf("tripletex: inventory: created stocktaking is empty")
+	}
+	return res.JSONDefault.Value, nil
+}
+
+func (s *InventoryService) addProductLine(ctx context.Context, line ProductLine) error {
+	res, err := s.client.InventoryStocktakingProductlinePostWithApplicationJSONCharsetUTF8BodyWithResponse(ctx, line)
+	if err != nil {
+		return fmt.Errorf("tripletex: inventory: failed to add product %d to stocktaking: %w", *line.Product.Id, err)
+	}
+	if err = checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return fmt.Errorf("tripletex: inventory: failed to add product %d to stocktaking: %w", *line.Product.Id, err)
+	}
+	return nil
+}
+
+// completeStocktaking marks the stocktaking with id as completed. It's read
+// first, as adding product lines changes its version.
+func (s *InventoryService) completeStocktaking(ctx context.Context, id int64) (*Stocktaking, error) {
+	f := "id,version"
+	current, err := s.client.InventoryStocktakingGetWithResponse(ctx, id, &InventoryStocktakingGetParams{Fields: &f})
+	if err != nil {
+		return nil, fmt.Errorf("tripletex: inventory: failed to get stocktaking %d: %w", id, err)
+	}
+	if err = checkResponse(current.HTTPResponse, current.Body); err != nil {
+		return nil, fmt.Errorf("tripletex: inventory: failed to get stocktaking %d: %w", id, err)
+	}
+	if current.JSONDefault == nil || current.JSONDefault.Value == nil {
+		return nil, fmt.Errorf("tripletex: inventory: stocktaking %d is empty", id)
+	}
+
+	completed := true
+	res, err := s.client.InventoryStocktakingPutWithApplicationJSONCharsetUTF8BodyWithResponse(ctx, id, Stocktaking{
+		Id:          &id,
+		Version:     current.JSONDefault.Value.Version,
+		IsCompleted: &completed,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("tripletex: inventory: failed to complete stocktaking %d: %w", id, err)
+	}
+	if err = checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return nil, fmt.Errorf("tripletex: inventory: failed to complete stocktaking %d: %w", id, err)
+	}
+	if res.JSONDefault == nil || res.JSONDefault.Value == nil {
+		return nil, fmt.Errorf("tripletex: inventory: completed stocktaking is empty")
+	}
+	return res.JSONDefault.Value, nil
+}
@@ -0,0 +1,100 @@
+package tripletex
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestInventoryStockLevels(t *testing.T) {
+	require := require.New(t)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /product/inventoryLocation", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal("1,2", r.URL.Query().Get("productId"))
+		writeTestJSON(w, http.StatusOK, ListResponseProductInventoryLocation{Values: &[]ProductInventoryLocation{
+			{Product: &Product{Id: ptr(int64(1))}, Inventory: &Inventory{Id: ptr(int64(10))}, StockOfGoods: ptr(float32(3))},
+			{Product: &Product{Id: ptr(int64(1))}, Inventory: &Inventory{Id: ptr(int64(10))}, StockOfGoods: ptr(float32(2))},
+			{Product: &Product{Id: ptr(int64(1))}, Inventory: &Inventory{Id: ptr(int64(11))}, StockOfGoods: ptr(float32(4))},
+			{Product: &Product{Id: ptr(int64(2))}, Inventory: &Inventory{Id: ptr(int64(11))}, StockOfGoods: ptr(float32(1))},
+		}})
+	})
+	c := newTestClient(t, mux)
+
+	levels, err := c.Inventory().StockLevels(context.Background(), 1, 2)
+	require.NoError(err)
+	require.Len(levels, 2)
+	require.Equal(float32(9), levels[1].Total)
+	require.Equal(map[int64]float32{10: 5, 11: 4}, levels[1].ByInventory)
+	require.Equal(float32(1), levels[2].Total)
+
+	levels, err = c.Inventory().StockLevels(context.Background())
+	require.NoError(err)
+	require.Empty(levels)
+}
+
+func TestInventoryCorrectStock(t *testing.T) {
+	require := require.New(t)
+
+	var lines []ProductLine
+	var steps []string
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /inventory/location", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal("10", r.URL.Query().Get("warehouseId"))
+		writeTestJSON(w, http.StatusOK, ListResponseInventoryLocation{Values: &[]InventoryLocation{
+			{Id: ptr(int64(100)), Name: ptr("A-10")},
+			{Id: ptr(int64(101)), Name: ptr("A-1")},
+		}})
+	})
+	mux.HandleFunc("POST /inventory/stocktaking", func(w http.ResponseWriter, r *http.Request) {
+		steps = append(steps, "create")
+		require.Equal("NO_PRODUCTS", r.URL.Query().Get("typeOfStocktaking"))
+		var stocktaking Stocktaking
+		require.NoError(json.NewDecoder(r.Body).Decode(&stocktaking))
+		require.Equal("2025-06-30", *stocktaking.Date)
+		writeTestJSON(w, http.StatusCreated, ResponseWrapperStocktaking{Value: &Stocktaking{Id: ptr(int64(5)), Version: ptr(int32(0))}})
+	})
+	mux.HandleFunc("POST /inventory/stocktaking/productline", func(w http.ResponseWriter, r *http.Request) {
+		steps = append(steps, "line")
+		var line ProductLine
+		require.NoError(json.NewDecoder(r.Body).Decode(&line))
+		lines = append(lines, line)
+		writeTestJSON(w, http.StatusCreated, ResponseWrapperProductLine{Value: &line})
+	})
+	mux.HandleFunc("GET /inventory/stocktaking/{id}", func(w http.ResponseWriter, r *http.Request) {
+		steps = append(steps, "get")
+		writeTestJSON(w, http.StatusOK, ResponseWrapperStocktaking{Value: &Stocktaking{Id: ptr(int64(5)), Version: ptr(int32(2))}})
+	})
+	mux.HandleFunc("PUT /inventory/stocktaking/{id}", func(w http.ResponseWriter, r *http.Request) {
+		steps = append(steps, "complete")
+		var stocktaking Stocktaking
+		require.NoError(json.NewDecoder(r.Body).Decode(&stocktaking))
+		require.True(*stocktaking.IsCompleted)
+		require.Equal(int32(2), *stocktaking.Version, "should use the version after the product lines")
+		writeTestJSON(w, http.StatusOK, ResponseWrapperStocktaking{Value: &stocktaking})
+	})
+	now := time.Date(2025, 6, 30, 12, 0, 0, 0, time.UTC)
+	c := newTestClient(t, mux, WithClock(func() time.Time { return now }))
+
+	stocktakings, err := c.Inventory().CorrectStock(context.Background(), []StockCorrection{
+		{ProductId: 1, InventoryId: 10, LocationName: "A-1", Count: 7},
+		{ProductId: 2, InventoryId: 10, Count: 3},
+	})
+	require.NoError(err)
+	require.Len(stocktakings, 1)
+	require.Equal([]string{"create", "line", "line", "get", "complete"}, steps)
+	require.Equal(int64(101), *lines[0].Location.Id)
+	require.Nil(lines[1].Location)
+
+	steps = nil
+	_, err = c.Inventory().CorrectStock(context.Background(), []StockCorrection{
+		{ProductId: 1, InventoryId: 10, LocationName: "B-1", Count: 7},
+	})
+	require.ErrorIs(err, ErrNotFound)
+	require.ErrorContains(err, `location "B-1"`)
+	require.Empty(steps)
+}
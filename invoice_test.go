@@ -0,0 +1,80 @@
+package tripletex
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestInvoiceOrder(t *testing.T) {
+	for _, tt := range []struct {
+		description string
+		order       Order
+		expectedErr error
+	}{
+		{
+			description: "open order",
+			order:       Order{Id: ptr(int64(3))},
+		},
+		{
+			description: "uncharged preliminary invoice",
+			order:       Order{Id: ptr(int64(3)), PreliminaryInvoice: &Invoice{Id: ptr(int64(8)), IsCharged: ptr(false)}},
+		},
+		{
+			description: "closed order",
+			order:       Order{Id: ptr(int64(3)), IsClosed: ptr(true)},
+			expectedErr: ErrOrderClosed,
+		},
+		{
+			description: "charged preliminary invoice",
+			order:       Order{Id: ptr(int64(3)), PreliminaryInvoice: &Invoice{Id: ptr(int64(8)), IsCharged: ptr(true)}},
+			expectedErr: ErrOrderAlreadyInvoiced,
+		},
+	} {
+		t.Run(tt.description, func(t *testing.T) {
+			require := require.New(t)
+
+			mux := http.NewServeMux()
+			mux.HandleFunc("GET /order/{id}", func(w http.ResponseWriter, r *http.Request) {
+				writeTestJSON(w, http.StatusOK, ResponseWrapperOrder{Value: &tt.order})
+			})
+			mux.HandleFunc("PUT /order/{id}/:invoice", func(w http.ResponseWriter, r *http.Request) {
+				require.Equal("2025-03-01", r.URL.Query().Get("invoiceDate"))
+				require.Equal("false", r.URL.Query().Get("sendToCustomer"))
+				writeTestJSON(w, http.StatusOK, ResponseWrapperInvoice{Value: &Invoice{Id: ptr(int64(42))}})
+			})
+			c := newTestClient(t, mux)
+
+			invoice, err := c.InvoiceOrder(context.Background(), 3, InvoiceOrderOptions{
+				InvoiceDate: time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC),
+			})
+			if tt.expectedErr != nil {
+				require.ErrorIs(err, tt.expectedErr)
+				return
+			}
+			require.NoError(err)
+			require.Equal(int64(42), *invoice.Id)
+		})
+	}
+}
+
+func TestInvoiceOrderDefaultDate(t *testing.T) {
+	require := require.New(t)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /order/{id}", func(w http.ResponseWriter, r *http.Request) {
+		writeTestJSON(w, http.StatusOK, ResponseWrapperOrder{Value: &Order{Id: ptr(int64(3))}})
+	})
+	mux.HandleFunc("PUT /order/{id}/:invoice", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal("2025-05-17", r.URL.Query().Get("invoiceDate"))
+		writeTestJSON(w, http.StatusOK, ResponseWrapperInvoice{Value: &Invoice{Id: ptr(int64(42))}})
+	})
+	now := time.Date(2025, 5, 17, 9, 0, 0, 0, time.UTC)
+	c := newTestClient(t, mux, WithClock(func() time.Time { return now }))
+
+	_, err := c.InvoiceOrder(context.Background(), 3, InvoiceOrderOptions{})
+	require.NoError(err)
+}
@@ -0,0 +1,95 @@
+package tripletex
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// openPostFields are the fields of open posts, enough to build a statement.
+const openPostFields = "id,date,description,amount,amountCurrency,invoiceNumber,termOfPayment," +
+	"currency(id,code),account(id,number),voucher(id,number,year)"
+
+// Statement is the open posts of a customer or supplier, eg. for dunning.
+//
+// Amounts are in NOK. For customers, positive amounts are owed by the
+// customer. For suppliers, negative amounts are owed to the supplier.
+type Statement struct {
+	AsOf    time.Time
+	Posts   []Posting
+	Balance float64 // Sum of the open posts
+	Overdue float64 // Sum of the open posts due before AsOf
+}
+
+// OpenPosts returns the open posts of the customer with id customerId as of
+// today, ie. invoices and payments not yet closed against each other.
+func (c *TripletexClient) OpenPosts(ctx context.Context, customerId int64) ([]Posting, error) {
+	return c.openPosts(ctx, c.now(), &LedgerPostingOpenPostOpenPostParams{CustomerId: &customerId})
+}
+
+// SupplierOpenPosts returns the open posts of the supplier with id
+// supplierId as of today. See [TripletexClient.OpenPosts].
+func (c *TripletexClient) SupplierOpenPosts(ctx context.Context, supplierId int64) ([]Posting, error) {
+	return c.openPosts(ctx, c.now(), &LedgerPostingOpenPostOpenPostParams{SupplierId: &supplierId})
+}
+
+// CustomerStatement returns the open posts of the customer with id
+// customerId as of today, with their balance and overdue amount.
+func (c *TripletexClient) CustomerStatement(ctx context.Context, customerId int64) (*Statement, error) {
+	asOf := c.now()
+	posts, err := c.openPosts(ctx, asOf, &LedgerPostingOpenPostOpenPostParams{CustomerId: &customerId})
+	if err != nil {
+		return nil, err
+	}
+	return newStatement(asOf, posts), nil
+}
+
+// SupplierStatement returns the open posts of the supplier with id
+// supplierId as of today, with their balance and overdue amount.
+func (c *TripletexClient) SupplierStatement(ctx context.Context, supplierId int64) (*Statement, error) {
+	asOf := c.now()
+	posts, err := c.openPosts(ctx, asOf, &LedgerPostingOpenPostOpenPostParams{SupplierId: &supplierId})
+	if err != nil {
+		return nil, err
+	}
+	return newStatement(asOf, posts), nil
+}
+
+// openPosts returns the open posts matching params on date.
+func (c *TripletexClient) openPosts(ctx context.Context, date time.Time, params *LedgerPostingOpenPostOpenPostParams) ([]Posting, error) {
+	params.Date = date.Format(time.DateOnly)
+	f := openPostFields
+	params.Fields = &f
+	return collectPages(ctx, 0, func(ctx context.Context, from, count int) ([]Posting, PageInfo, error) {
+		params.From, params.Count = &from, &count
+		res, err := c.LedgerPostingOpenPostOpenPostWithResponse(ctx, params)
+		if err != nil {
+			return nil, PageInfo{}, fmt.Errorf("tripletex: open post: failed to search open posts: %w", err)
+		}
+		if err = checkResponse(res.HTTPResponse, res.Body); err != nil {
+			return nil, PageInfo{}, fmt.Errorf("tripletex: open post: failed to search open posts: %w", err)
+		}
+		if res.JSONDefault == nil {
+			return nil, PageInfo{}, nil
+		}
+		return listValues(res.JSONDefault.Values), res.JSONDefault.PageInfo(), nil
+	})
+}
+
+// newStatement sums posts as of asOf. Posts without a due date are never
+// overdue.
+func newStatement(asOf time.Time, posts []Posting) *Statement {
+	today := asOf.Format(time.DateOnly)
+	s := &Statement{AsOf: asOf, Posts: posts}
+	for _, p := range posts {
+		if p.Amount == nil {
+			continue
+		}
+		amount := float64(*p.Amount)
+		s.Balance += amount
+		if dateBefore(p.TermOfPayment, today) {
+			s.Overdue += amount
+		}
+	}
+	return s
+}
@@ -0,0 +1,37 @@
+package tripletex
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCustomerStatement(t *testing.T) {
+	require := require.New(t)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /ledger/posting/openPost", func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		require.Equal("2025-03-15", q.Get("date"))
+		require.Equal("9", q.Get("customerId"))
+		require.Empty(q.Get("supplierId"))
+		writeTestJSON(w, http.StatusOK, ListResponsePosting{Values: &[]Posting{
+			{Id: ptr(int64(1)), Amount: ptr(float32(1000)), TermOfPayment: ptr("2025-03-01")},
+			{Id: ptr(int64(2)), Amount: ptr(float32(500)), TermOfPayment: ptr("2025-03-15")},
+			{Id: ptr(int64(3)), Amount: ptr(float32(-200)), TermOfPayment: ptr("2025-02-01")},
+			{Id: ptr(int64(4)), Amount: ptr(float32(50))},
+		}})
+	})
+	now := time.Date(2025, 3, 15, 10, 0, 0, 0, time.UTC)
+	c := newTestClient(t, mux, WithClock(func() time.Time { return now }))
+
+	statement, err := c.CustomerStatement(context.Background(), 9)
+	require.NoError(err)
+	require.Len(statement.Posts, 4)
+	require.Equal(1350.0, statement.Balance)
+	require.Equal(800.0, statement.Overdue, "should not count posts due today or without due date")
+	require.Equal(now, statement.AsOf)
+}
@@ -0,0 +1,39 @@
+package tripletex
+
+import (
+	"context"
+
+	"github.com/valuetechdev/tripletex-go/paging"
+)
+
+// defaultPageSize is the number of values requested per page when iterating
+// over list endpoints.
+const defaultPageSize = paging.DefaultSize
+
+// PageInfo is the pagination metadata of a list response, see
+// [paging.PageInfo].
+type PageInfo = paging.PageInfo
+
+// pageFunc fetches at most count values starting at index from, and returns
+// them with the [PageInfo] of the list response.
+type pageFunc[T any] = paging.Func[T]
+
+// collectPages calls fetch with increasing offsets until a page without more
+// values after it is returned, and returns all values in order. See
+// [paging.Collect].
+func collectPages[T any](ctx context.Context, pageSize int, fetch pageFunc[T]) ([]T, error) {
+	return paging.Collect(ctx, pageSize, fetch)
+}
+
+// forEachPage calls fetch with increasing offsets until a page without more
+// values after it is returned, and calls fn with each page. See
+// [paging.ForEach].
+func forEachPage[T any](ctx context.Context, pageSize int, fetch pageFunc[T], fn func(page []T) error) error {
+	return paging.ForEach(ctx, pageSize, fetch, fn)
+}
+
+// listValues dereferences the values of a list response, returning nil if
+// missing.
+func listValues[T any](v *[]T) []T {
+	return paging.Values(v)
+}
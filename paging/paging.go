@@ -0,0 +1,111 @@
+// Package paging iterates over the values of the list endpoints of the
+// Tripletex API, which take the index of the first value and the number of
+// values as the from and count parameters.
+//
+// It's shared by the tripletex package and applications using the domain
+// clients in api/... directly:
+//
+//	customers, err := paging.Collect(ctx, 0, func(ctx context.Context, from, count int) ([]models.Customer, paging.PageInfo, error) {
+//		res, err := c.CustomerSearchWithResponse(ctx, &models.CustomerSearchParams{From: &from, Count: &count})
+//		...
+//		return paging.Values(res.JSONDefault.Values), res.JSONDefault.PageInfo(), nil
+//	})
+//
+// The list responses of api/models describe their page with a PageInfo
+// method, which tells the iteration whether to fetch another page.
+package paging
+
+import (
+	"context"
+)
+
+// DefaultSize is the number of values requested per page if the page size
+// is 0 or less.
+const DefaultSize = 1000
+
+// Func fetches at most count values starting at index from, and returns them
+// with the [PageInfo] of the list response.
+type Func[T any] func(ctx context.Context, from, count int) ([]T, PageInfo, error)
+
+// Collect calls fetch with increasing offsets until a page without more
+// values after it is returned, and returns all values in order.
+//
+// Uses [DefaultSize] if size is 0 or less.
+func Collect[T any](ctx context.Context, size int, fetch Func[T]) ([]T, error) {
+	var all []T
+	err := ForEach(ctx, size, fetch, func(page []T) error {
+		all = append(all, page...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return all, nil
+}
+
+// ForEach calls fetch with increasing offsets until a page without more
+// values after it, as reported by [PageInfo.HasMore], or an empty page is
+// returned, and calls fn with each page. Stops if fn returns error.
+//
+// Uses [DefaultSize] if size is 0 or less.
+func ForEach[T any](ctx context.Context, size int, fetch Func[T], fn func(page []T) error) error {
+	if size <= 0 {
+		size = DefaultSize
+	}
+
+	for from := 0; ; {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		page, info, err := fetch(ctx, from, size)
+		if err != nil {
+			return err
+		}
+		if err = fn(page); err != nil {
+			return err
+		}
+		if !info.HasMore || len(page) == 0 {
+			return nil
+		}
+		from += len(page)
+	}
+}
+
+// Values dereferences the values of a list response, returning nil if
+// missing.
+func Values[T any](v *[]T) []T {
+	if v == nil {
+		return nil
+	}
+	return *v
+}
+
+// PageInfo is the pagination metadata of a list response.
+type PageInfo struct {
+	From  int // Index of the first value
+	Count int // Number of values in the response
+	// FullResultSize is the number of values matching the request, which
+	// the API notes may not be exact.
+	FullResultSize int
+	HasMore        bool // Whether values remain after the response
+}
+
+// NewPageInfo returns the [PageInfo] of the from, count and fullResultSize
+// fields of a list response, treating missing fields as 0. The list
+// responses of the models have it as their PageInfo method.
+func NewPageInfo(from, count, fullResultSize *int64) PageInfo {
+	info := PageInfo{
+		From:           int(deref(from)),
+		Count:          int(deref(count)),
+		FullResultSize: int(deref(fullResultSize)),
+	}
+	info.HasMore = info.From+info.Count < info.FullResultSize
+	return info
+}
+
+func deref(v *int64) int64 {
+	if v == nil {
+		return 0
+	}
+	return *v
+}
@@ -0,0 +1,88 @@
+package paging
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCollect(t *testing.T) {
+	tests := []struct {
+		description string
+		total       int
+		size        int
+		maxCount    int // Values returned per page by the API, if less than size
+		wantCalls   int
+	}{
+		{description: "empty", total: 0, size: 10, wantCalls: 1},
+		{description: "partial page", total: 5, size: 10, wantCalls: 1},
+		{description: "exact pages", total: 20, size: 10, wantCalls: 2},
+		{description: "default size", total: 1500, size: 0, wantCalls: 2},
+		{description: "pages shorter than size", total: 25, size: 10, maxCount: 5, wantCalls: 5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			require := require.New(t)
+
+			calls := 0
+			values, err := Collect(context.Background(), tt.size, func(ctx context.Context, from, count int) ([]int, PageInfo, error) {
+				calls++
+				if tt.maxCount > 0 {
+					count = min(count, tt.maxCount)
+				}
+				var page []int
+				for i := from; i < min(from+count, tt.total); i++ {
+					page = append(page, i)
+				}
+				return page, PageInfo{From: from, Count: len(page), FullResultSize: tt.total, HasMore: from+len(page) < tt.total}, nil
+			})
+			require.NoError(err)
+			require.Len(values, tt.total)
+			for i, v := range values {
+				require.Equal(i, v)
+			}
+			require.Equal(tt.wantCalls, calls)
+		})
+	}
+}
+
+func TestForEachStops(t *testing.T) {
+	require := require.New(t)
+
+	errStop := errors.New("stop")
+	pages := 0
+	err := ForEach(context.Background(), 1, func(ctx context.Context, from, count int) ([]int, PageInfo, error) {
+		return []int{from}, PageInfo{From: from, Count: 1, HasMore: true}, nil
+	}, func(page []int) error {
+		pages++
+		if pages == 3 {
+			return errStop
+		}
+		return nil
+	})
+	require.ErrorIs(err, errStop)
+	require.Equal(3, pages)
+}
+
+func TestNewPageInfo(t *testing.T) {
+	n := func(v int64) *int64 { return &v }
+	tests := []struct {
+		description    string
+		from           *int64
+		count          *int64
+		fullResultSize *int64
+		expected       PageInfo
+	}{
+		{description: "more", from: n(0), count: n(10), fullResultSize: n(25), expected: PageInfo{From: 0, Count: 10, FullResultSize: 25, HasMore: true}},
+		{description: "last page", from: n(20), count: n(5), fullResultSize: n(25), expected: PageInfo{From: 20, Count: 5, FullResultSize: 25}},
+		{description: "empty", from: n(0), count: n(0), fullResultSize: n(0), expected: PageInfo{}},
+		{description: "missing fields", expected: PageInfo{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			require.Equal(t, tt.expected, NewPageInfo(tt.from, tt.count, tt.fullResultSize))
+		})
+	}
+}
@@ -0,0 +1,168 @@
+package tripletex
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// PaymentService groups the supplier invoice approval and payment endpoints,
+// for accounts payable automation.
+//
+// Use [TripletexClient.Payments] to get one.
+type PaymentService struct {
+	client *TripletexClient
+}
+
+// Payments returns a [PaymentService] using c.
+func (c *TripletexClient) Payments() *PaymentService {
+	return &PaymentService{client: c}
+}
+
+// SupplierPayment is a payment of a supplier invoice, see
+// [PaymentService.Pay].
+type SupplierPayment struct {
+	PaymentTypeId int32     // Outgoing payment type, or 0 for the default of the supplier
+	Amount        float32   // Optional, defaults to the outstanding amount
+	Date          time.Time // Optional, defaults to today
+	KID           string    // Optional, KID or message to the receiver
+	Bban          string    // Optional, overrides the supplier's bank account
+	Partial       bool      // Allow more payments to be registered later
+}
+
+// ForApproval returns the supplier invoices awaiting approval by the employee
+// with id employeeId, or by the employee of the session token if 0.
+func (s *PaymentService) ForApproval(ctx context.Context, employeeId int64) ([]SupplierInvoice, error) {
+	params := SupplierInvoiceForApprovalGetApprovalInvoicesParams{}
+	if employeeId != 0 {
+		params.EmployeeId = &employeeId
+	}
+	return collectPages(ctx, 0, func(ctx context.Context, from, count int) ([]SupplierInvoice, PageInfo, error) {
+		params.From, params.Count = &from, &count
+		res, err := s.client.SupplierInvoiceForApprovalGetApprovalInvoicesWithResponse(ctx, &params)
+		if err != nil {
+			return nil, PageInfo{}, fmt.Errorf("tripletex: payment: failed to search invoices for approval: %w", err)
+		}
+		if err = checkResponse(res.HTTPResponse, res.Body); err != nil {
+			return nil, PageInfo{}, fmt.Errorf("tripletex: payment: failed to search invoices for approval: %w", err)
+		}
+		if res.JSONDefault == nil {
+			return nil, PageInfo{}, nil
+		}
+		return listValues(res.JSONDefault.Values), res.JSONDefault.PageInfo(), nil
+	})
+}
+
+// Approve approves the supplier invoices with ids, with an optional comment.
+func (s *PaymentService) Approve(ctx context.Context, comment string, ids ...int64) ([]SupplierInvoice, error) {
+	idList := joinIds(ids)
+	params := &SupplierInvoiceApproveApproveManyParams{InvoiceIds: &idList}
+	if comment != "" {
+		params.Comment = &comment
+	}
+	res, err := s.client.SupplierInvoiceApproveApproveManyWithResponse(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("tripletex: payment: failed to approve %s: %w", idList, err)
+	}
+	if err = checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return nil, fmt.Errorf("tripletex: payment: failed to approve %s: %w", idList, err)
+	}
+	if res.JSONDefault == nil {
+		return nil, nil
+	}
+	return listValues(res.JSONDefault.Values), nil
+}
+
+// Reject rejects the supplier invoices with ids. Tripletex requires a
+// comment explaining why.
+func (s *PaymentService) Reject(ctx context.Context, comment string, ids ...int64) ([]SupplierInvoice, error) {
+	if comment == "" {
+		return nil, fmt.Errorf("tripletex: payment: rejecting requires a comment")
+	}
+	idList := joinIds(ids)
+	res, err := s.client.SupplierInvoiceRejectRejectManyWithResponse(ctx, &SupplierInvoiceRejectRejectManyParams{
+		Comment:    comment,
+		InvoiceIds: &idList,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("tripletex: payment: failed to reject %s: %w", idList, err)
+	}
+	if err = checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return nil, fmt.Errorf("tripletex: payment: failed to reject %s: %w", idList, err)
+	}
+	if res.JSONDefault == nil {
+		return nil, nil
+	}
+	return listValues(res.JSONDefault.Values), nil
+}
+
+// Pay registers payment of the supplier invoice with id invoiceId.
+func (s *PaymentService) Pay(ctx context.Context, invoiceId int64, payment SupplierPayment) (*SupplierInvoice, error) {
+	params := &SupplierInvoiceAddPaymentAddPaymentParams{PaymentType: payment.PaymentTypeId}
+	if payment.PaymentTypeId == 0 {
+		useDefault := true
+		params.UseDefaultPaymentType = &useDefault
+	}
+	if payment.Amount != 0 {
+		params.Amount = &payment.Amount
+	}
+	if !payment.Date.IsZero() {
+		date := payment.Date.Format(time.DateOnly)
+		params.PaymentDate = &date
+	}
+	if payment.KID != "" {
+		params.KidOrReceiverReference = &payment.KID
+	}
+	if payment.Bban != "" {
+		params.Bban = &payment.Bban
+	}
+	if payment.Partial {
+		params.PartialPayment = &payment.Partial
+	}
+
+	res, err := s.client.SupplierInvoiceAddPaymentAddPaymentWithResponse(ctx, invoiceId, params)
+	if err != nil {
+		return nil, fmt.Errorf("tripletex: payment: failed to pay %d: %w", invoiceId, err)
+	}
+	if err = checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return nil, fmt.Errorf("tripletex: payment: failed to pay %d: %w", invoiceId, err)
+	}
+	if res.JSONDefault == nil || res.JSONDefault.Value == nil {
+		return nil, fmt.Errorf("tripletex: payment: paid invoice %d is empty", invoiceId)
+	}
+	return res.JSONDefault.Value, nil
+}
+
+// ApproveAndPay approves the supplier invoice with id invoiceId and registers
+// payment of it, the usual flow for invoices that are ready to pay.
+//
+// If paying fails, the invoice stays approved and the error is returned, so
+// it can be paid again with [PaymentService.Pay].
+func (s *PaymentService) ApproveAndPay(ctx context.Context, invoiceId int64, comment string, payment SupplierPayment) (*SupplierInvoice, error) {
+	if _, err := s.Approve(ctx, comment, invoiceId); err != nil {
+		return nil, err
+	}
+	return s.Pay(ctx, invoiceId, payment)
+}
+
+// PaymentTypes returns the active payment types for outgoing payments.
+func (s *PaymentService) PaymentTypes(ctx context.Context) ([]PaymentTypeOut, error) {
+	inactive := false
+	return collectPages(ctx, 0, func(ctx context.Context, from, count int) ([]PaymentTypeOut, PageInfo, error) {
+		res, err := s.client.LedgerPaymentTypeOutSearchWithResponse(ctx, &LedgerPaymentTypeOutSearchParams{
+			IsInactive: &inactive,
+			From:       &from,
+			Count:      &count,
+		})
+		if err != nil {
+			return nil, PageInfo{}, fmt.Errorf("tripletex: payment: failed to search payment types: %w", err)
+		}
+		if err = checkResponse(res.HTTPResponse, res.Body); err != nil {
+			return nil, PageInfo{}, fmt.Errorf("tripletex: payment: failed to search payment types: %w", err)
+		}
+		if res.JSONDefault == nil {
+			return nil, PageInfo{}, nil
+		}
+		return listValues(res.JSONDefault.Values), res.JSONDefault.PageInfo(), nil
+	})
+}
@@ -0,0 +1,84 @@
+package tripletex
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPaymentApproveAndPay(t *testing.T) {
+	tests := []struct {
+		description    string
+		payment        SupplierPayment
+		expectedParams map[string]string
+	}{
+		{
+			description: "default payment type",
+			payment:     SupplierPayment{},
+			expectedParams: map[string]string{
+				"paymentType":           "0",
+				"useDefaultPaymentType": "true",
+				"amount":                "",
+				"paymentDate":           "",
+			},
+		},
+		{
+			description: "explicit payment",
+			payment: SupplierPayment{
+				PaymentTypeId: 5,
+				Amount:        1250.5,
+				Date:          time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC),
+				KID:           "12345",
+				Partial:       true,
+			},
+			expectedParams: map[string]string{
+				"paymentType":            "5",
+				"useDefaultPaymentType":  "",
+				"amount":                 "1250.5",
+				"paymentDate":            "2025-03-31",
+				"kidOrReceiverReference": "12345",
+				"partialPayment":         "true",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			require := require.New(t)
+
+			var steps []string
+			mux := http.NewServeMux()
+			mux.HandleFunc("PUT /supplierInvoice/:approve", func(w http.ResponseWriter, r *http.Request) {
+				steps = append(steps, "approve")
+				require.Equal("7", r.URL.Query().Get("invoiceIds"))
+				require.Equal("ok", r.URL.Query().Get("comment"))
+				writeTestJSON(w, http.StatusOK, ListResponseSupplierInvoice{Values: &[]SupplierInvoice{{Id: ptr(int64(7))}}})
+			})
+			mux.HandleFunc("POST /supplierInvoice/{id}/:addPayment", func(w http.ResponseWriter, r *http.Request) {
+				steps = append(steps, "pay")
+				require.Equal("7", r.PathValue("id"))
+				q := r.URL.Query()
+				for key, value := range tt.expectedParams {
+					require.Equal(value, q.Get(key), key)
+				}
+				writeTestJSON(w, http.StatusOK, ResponseWrapperSupplierInvoice{Value: &SupplierInvoice{Id: ptr(int64(7))}})
+			})
+			c := newTestClient(t, mux)
+
+			invoice, err := c.Payments().ApproveAndPay(context.Background(), 7, "ok", tt.payment)
+			require.NoError(err)
+			require.Equal(int64(7), *invoice.Id)
+			require.Equal([]string{"approve", "pay"}, steps)
+		})
+	}
+}
+
+func TestPaymentRejectRequiresComment(t *testing.T) {
+	require := require.New(t)
+
+	c := newTestClient(t, http.NewServeMux())
+	_, err := c.Payments().Reject(context.Background(), "", 7)
+	require.ErrorContains(err, "requires a comment")
+}
@@ -0,0 +1,128 @@
+package tripletex
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+)
+
+// PostingMatch is a posting with a positive amount and a posting with the
+// opposite amount, of the same customer or supplier, that settle each other,
+// see [MatchPostings].
+//
+// For customers, Debit is usually the invoice and Credit the payment. For
+// suppliers it's the other way around.
+type PostingMatch struct {
+	Debit  Posting // Positive amount
+	Credit Posting // Negative amount
+}
+
+// MatchPostings returns the candidate matches among posts, eg. open posts
+// from [TripletexClient.OpenPosts], pairing each posting with one of the
+// opposite amount to the øre.
+//
+// When several postings have the opposite amount, one with the same invoice
+// number is preferred, then the first in posts. Each posting is used at most
+// once.
+func MatchPostings(posts []Posting) []PostingMatch {
+	used := make([]bool, len(posts))
+	var matches []PostingMatch
+	for i, p := range posts {
+		amount, ok := postingOre(p)
+		if used[i] || !ok || amount <= 0 {
+			continue
+		}
+
+		candidate := -1
+		for j, q := range posts {
+			if used[j] || j == i {
+				continue
+			}
+			if other, ok := postingOre(q); !ok || other != -amount {
+				continue
+			}
+			if candidate == -1 {
+				candidate = j
+			}
+			if sameInvoiceNumber(p, q) {
+				candidate = j
+				break
+			}
+		}
+		if candidate == -1 {
+			continue
+		}
+
+		used[i], used[candidate] = true, true
+		matches = append(matches, PostingMatch{Debit: p, Credit: posts[candidate]})
+	}
+	return matches
+}
+
+// postingOre returns the amount of p in øre.
+func postingOre(p Posting) (int64, bool) {
+	if p.Id == nil || p.Amount == nil {
+		return 0, false
+	}
+	return int64(math.Round(float64(*p.Amount) * 100)), true
+}
+
+func sameInvoiceNumber(p, q Posting) bool {
+	return p.InvoiceNumber != nil && q.InvoiceNumber != nil && *p.InvoiceNumber != "" && *p.InvoiceNumber == *q.InvoiceNumber
+}
+
+// ClosePostings closes the postings with ids against each other. They must
+// be of the same customer, supplier or employee, and sum to 0.
+//
+// Uses the /ledger/voucher/historical/:closePostings endpoint, which is in
+// BETA in the Tripletex API and may change.
+func (c *TripletexClient) ClosePostings(ctx context.Context, ids ...int64) error {
+	idList := joinIds(ids)
+	res, err := c.LedgerVoucherHistoricalClosePostingsClosePostingsWithResponse(ctx, &LedgerVoucherHistoricalClosePostingsClosePostingsParams{}, idList)
+	if err != nil {
+		return fmt.Errorf("tripletex: posting: failed to close %s: %w", idList, err)
+	}
+	if err = checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return fmt.Errorf("tripletex: posting: failed to close %s: %w", idList, err)
+	}
+	return nil
+}
+
+// CloseCustomerMatches closes the matches found by [MatchPostings] among the
+// open posts of the customer with id customerId, and returns the matches
+// closed.
+//
+// Matches failing to close are skipped, and their errors returned joined
+// along with the matches that were closed.
+func (c *TripletexClient) CloseCustomerMatches(ctx context.Context, customerId int64) ([]PostingMatch, error) {
+	posts, err := c.OpenPosts(ctx, customerId)
+	if err != nil {
+		return nil, err
+	}
+	return c.closeMatches(ctx, MatchPostings(posts))
+}
+
+// CloseSupplierMatches closes the matches found by [MatchPostings] among the
+// open posts of the supplier with id supplierId. See
+// [TripletexClient.CloseCustomerMatches].
+func (c *TripletexClient) CloseSupplierMatches(ctx context.Context, supplierId int64) ([]PostingMatch, error) {
+	posts, err := c.SupplierOpenPosts(ctx, supplierId)
+	if err != nil {
+		return nil, err
+	}
+	return c.closeMatches(ctx, MatchPostings(posts))
+}
+
+func (c *TripletexClient) closeMatches(ctx context.Context, matches []PostingMatch) ([]PostingMatch, error) {
+	var closed []PostingMatch
+	var errs []error
+	for _, m := range matches {
+		if err := c.ClosePostings(ctx, *m.Debit.Id, *m.Credit.Id); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		closed = append(closed, m)
+	}
+	return closed, errors.Join(errs...)
+}
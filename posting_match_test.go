@@ -0,0 +1,87 @@
+package tripletex
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatchPostings(t *testing.T) {
+	posting := func(id int64, amount float32, invoiceNumber string) Posting {
+		p := Posting{Id: &id, Amount: &amount}
+		if invoiceNumber != "" {
+			p.InvoiceNumber = &invoiceNumber
+		}
+		return p
+	}
+
+	tests := []struct {
+		description string
+		posts       []Posting
+		expected    [][2]int64 // Debit and credit ids
+	}{
+		{
+			description: "opposite amounts",
+			posts:       []Posting{posting(1, 100, ""), posting(2, -100, "")},
+			expected:    [][2]int64{{1, 2}},
+		},
+		{
+			description: "prefers same invoice number",
+			posts:       []Posting{posting(1, 100, "10"), posting(2, -100, ""), posting(3, -100, "10")},
+			expected:    [][2]int64{{1, 3}},
+		},
+		{
+			description: "uses each posting once",
+			posts:       []Posting{posting(1, 100, ""), posting(2, 100, ""), posting(3, -100, "")},
+			expected:    [][2]int64{{1, 3}},
+		},
+		{
+			description: "rounds to øre",
+			posts:       []Posting{posting(1, 99.999, ""), posting(2, -100, "")},
+			expected:    [][2]int64{{1, 2}},
+		},
+		{
+			description: "no match for partial payment",
+			posts:       []Posting{posting(1, 100, ""), posting(2, -60, "")},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			var actual [][2]int64
+			for _, m := range MatchPostings(tt.posts) {
+				actual = append(actual, [2]int64{*m.Debit.Id, *m.Credit.Id})
+			}
+			require.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestCloseCustomerMatches(t *testing.T) {
+	require := require.New(t)
+
+	var closed []string
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /ledger/posting/openPost", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal("9", r.URL.Query().Get("customerId"))
+		writeTestJSON(w, http.StatusOK, ListResponsePosting{Values: &[]Posting{
+			{Id: ptr(int64(1)), Amount: ptr(float32(100))},
+			{Id: ptr(int64(2)), Amount: ptr(float32(-100))},
+			{Id: ptr(int64(3)), Amount: ptr(float32(50))},
+		}})
+	})
+	mux.HandleFunc("PUT /ledger/voucher/historical/:closePostings", func(w http.ResponseWriter, r *http.Request) {
+		var ids string
+		require.NoError(json.NewDecoder(r.Body).Decode(&ids))
+		closed = append(closed, ids)
+		w.WriteHeader(http.StatusOK)
+	})
+	c := newTestClient(t, mux)
+
+	matches, err := c.CloseCustomerMatches(context.Background(), 9)
+	require.NoError(err)
+	require.Len(matches, 1)
+	require.Equal([]string{"1,2"}, closed)
+}
@@ -0,0 +1,74 @@
+package tripletex
+
+import (
+	"context"
+	"fmt"
+)
+
+// ProductService groups the product endpoints.
+//
+// Use [TripletexClient.Products] to get one.
+type ProductService struct {
+	client *TripletexClient
+}
+
+// Products returns a [ProductService] using c.
+func (c *TripletexClient) Products() *ProductService {
+	return &ProductService{client: c}
+}
+
+// BulkUpsert creates products without an id and updates products with an id,
+// using the /product/list endpoints.
+//
+// Products are sent in batches (see [WithBulkBatchSize]) with bounded
+// concurrency (see [WithBulkConcurrency]). A batch rejected by Tripletex is
+// bisected to isolate the products causing the rejection, so the rest of the
+// batch succeeds, unless [WithoutBulkBisection] is set. A failing batch does
+// not stop the other batches; check [BulkResult.Failed] for the products that
+// failed.
+func (s *ProductService) BulkUpsert(ctx context.Context, products []Product, options ...BulkOption) *BulkResult[Product] {
+	cfg := newBulkConfig(options)
+
+	result := &BulkResult[Product]{Items: make([]BulkItem[Product], len(products))}
+	var creates, updates []int
+	for i, p := range products {
+		result.Items[i] = BulkItem[Product]{Index: i, Input: p}
+		if p.Id != nil {
+			updates = append(updates, i)
+		} else {
+			creates = append(creates, i)
+		}
+	}
+
+	runBulk(ctx, cfg, result.Items, creates, s.createList)
+	runBulk(ctx, cfg, result.Items, updates, s.updateList)
+	return result
+}
+
+func (s *ProductService) createList(ctx context.Context, products []Product) ([]Product, error) {
+	res, err := s.client.ProductListPostListWithApplicationJSONCharsetUTF8BodyWithResponse(ctx, products)
+	if err != nil {
+		return nil, fmt.Errorf("tripletex: product: failed to create list: %w", err)
+	}
+	if err = checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return nil, fmt.Errorf("tripletex: product: failed to create list: %w", err)
+	}
+	if res.JSONDefault == nil {
+		return nil, nil
+	}
+	return listValues(res.JSONDefault.Values), nil
+}
+
+func (s *ProductService) updateList(ctx context.Context, products []Product) ([]Product, error) {
+	res, err := s.client.ProductListPutListWithApplicationJSONCharsetUTF8BodyWithResponse(ctx, products)
+	if err != nil {
+		return nil, fmt.Errorf("tripletex: product: failed to update list: %w", err)
+	}
+	if err = checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return nil, fmt.Errorf("tripletex: product: failed to update list: %w", err)
+	}
+	if res.JSONDefault == nil {
+		return nil, nil
+	}
+	return listValues(res.JSONDefault.Values), nil
+}
@@ -0,0 +1,66 @@
+package tripletex
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestProductBulkUpsert(t *testing.T) {
+	require := require.New(t)
+
+	var posts, puts atomic.Int32
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /product/list", func(w http.ResponseWriter, r *http.Request) {
+		posts.Add(1)
+		var products []Product
+		require.NoError(json.NewDecoder(r.Body).Decode(&products))
+		require.LessOrEqual(len(products), 2)
+		for i, p := range products {
+			if *p.Name == "bad" {
+				writeTestJSON(w, http.StatusUnprocessableEntity, APIError{Status: 422, Message: "Validation failed"})
+				return
+			}
+			products[i].Id = ptr(int64(100 + i))
+		}
+		writeTestJSON(w, http.StatusCreated, ListResponseProduct{Values: &products})
+	})
+	mux.HandleFunc("PUT /product/list", func(w http.ResponseWriter, r *http.Request) {
+		puts.Add(1)
+		var products []Product
+		require.NoError(json.NewDecoder(r.Body).Decode(&products))
+		writeTestJSON(w, http.StatusOK, ListResponseProduct{Values: &products})
+	})
+	c := newTestClient(t, mux)
+	products := []Product{
+		{Name: ptr("a")},
+		{Name: ptr("b")},
+		{Name: ptr("c"), Id: ptr(int64(7))},
+		{Name: ptr("bad")},
+		{Name: ptr("d")},
+	}
+
+	result := c.Products().BulkUpsert(context.Background(), products, WithBulkBatchSize(2), WithBulkConcurrency(2))
+	require.Equal(int32(4), posts.Load(), "should bisect the rejected batch")
+	require.Equal(int32(1), puts.Load())
+	require.Len(result.Items, 5)
+	require.Len(result.Succeeded(), 4)
+	failed := result.Failed()
+	require.Len(failed, 1)
+	require.Equal(3, failed[0].Index)
+	require.Error(result.Err())
+	require.Equal(int64(7), *result.Items[2].Value.Id)
+	require.Equal("d", *result.Items[4].Value.Name)
+
+	posts.Store(0)
+	result = c.Products().BulkUpsert(context.Background(), products, WithBulkBatchSize(2), WithoutBulkBisection())
+	require.Equal(int32(2), posts.Load())
+	failed = result.Failed()
+	require.Len(failed, 2)
+	require.Equal(3, failed[0].Index)
+	require.Equal(4, failed[1].Index)
+}
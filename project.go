@@ -0,0 +1,192 @@
+package tripletex
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// ProjectService groups the project, participant, activity and budget
+// endpoints.
+//
+// Use [TripletexClient.Projects] to get one.
+type ProjectService struct {
+	client *TripletexClient
+}
+
+// Projects returns a [ProjectService] using c.
+func (c *TripletexClient) Projects() *ProjectService {
+	return &ProjectService{client: c}
+}
+
+// Get returns the project with id.
+func (s *ProjectService) Get(ctx context.Context, id int64) (*Project, error) {
+	f := "*,participants(*),projectActivities(*,activity(id,name))"
+	res, err := s.client.ProjectGetWithResponse(ctx, id, &ProjectGetParams{Fields: &f})
+	if err != nil {
+		return nil, fmt.Errorf("tripletex: project: failed to get %d: %w", id, err)
+	}
+	if err = checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return nil, fmt.Errorf("tripletex: project: failed to get %d: %w", id, err)
+	}
+	if res.JSONDefault == nil || res.JSONDefault.Value == nil {
+		return nil, fmt.Errorf("tripletex: project: %d is empty", id)
+	}
+	return res.JSONDefault.Value, nil
+}
+
+// Create creates project and adds the employees with participantIds as
+// participants.
+//
+// If adding participants fails, the created project is returned along with the
+// error.
+func (s *ProjectService) Create(ctx context.Context, project Project, participantIds ...int64) (*Project, error) {
+	res, err := s.client.ProjectPostWithApplicationJSONCharsetUTF8BodyWithResponse(ctx, project)
+	if err != nil {
+		return nil, fmt.Errorf("tripletex: project: failed to create: %w", err)
+	}
+	if err = checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return nil, fmt.Errorf("tripletex: project: failed to create: %w", err)
+	}
+	if res.JSONDefault == nil || res.JSONDefault.Value == nil || res.JSONDefault.Value.Id == nil {
+		return nil, fmt.Errorf("tripletex: project: created project is empty")
+	}
+
+	created := res.JSONDefault.Value
+	if len(participantIds) == 0 {
+		return created, nil
+	}
+	participants, err := s.AddParticipants(ctx, *created.Id, false, participantIds...)
+	if err != nil {
+		return created, err
+	}
+	created.Participants = &participants
+	return created, nil
+}
+
+// AddParticipants adds the employees with employeeIds as participants of the
+// project with id projectId.
+func (s *ProjectService) AddParticipants(ctx context.Context, projectId int64, adminAccess bool, employeeIds ...int64) ([]ProjectParticipant, error) {
+	participants := make([]ProjectParticipant, len(employeeIds))
+	for i, id := range employeeIds {
+		participants[i] = ProjectParticipant{
+			Project:     &Project{Id: &projectId},
+			Employee:    &Employee{Id: &id},
+			AdminAccess: &adminAccess,
+		}
+	}
+
+	res, err := s.client.ProjectParticipantListPostListWithApplicationJSONCharsetUTF8BodyWithResponse(ctx, participants)
+	if err != nil {
+		return nil, fmt.Errorf("tripletex: project: failed to add participants to %d: %w", projectId, err)
+	}
+	if err = checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return nil, fmt.Errorf("tripletex: project: failed to add participants to %d: %w", projectId, err)
+	}
+	if res.JSONDefault == nil {
+		return nil, nil
+	}
+	return listValues(res.JSONDefault.Values), nil
+}
+
+// AddParticipantsByName is like [ProjectService.AddParticipants] for the
+// project and employees with names or numbers, resolved with
+// [TripletexClient.Resolver].
+func (s *ProjectService) AddParticipantsByName(ctx context.Context, project string, adminAccess bool, employees ...string) ([]ProjectParticipant, error) {
+	projectId, err := s.client.Resolver().ProjectId(ctx, project)
+	if err != nil {
+		return nil, err
+	}
+	employeeIds := make([]int64, len(employees))
+	for i, employee := range employees {
+		if employeeIds[i], err = s.client.Resolver().EmployeeId(ctx, employee); err != nil {
+			return nil, err
+		}
+	}
+	return s.AddParticipants(ctx, projectId, adminAccess, employeeIds...)
+}
+
+// LinkActivity links the activity with id activityId to the project with id
+// projectId.
+func (s *ProjectService) LinkActivity(ctx context.Context, projectId, activityId int64) (*ProjectActivity, error) {
+	res, err := s.client.ProjectProjectActivityPostWithApplicationJSONCharsetUTF8BodyWithResponse(ctx, ProjectActivity{
+		Project:  &Project{Id: &projectId},
+		Activity: &Activity{Id: &activityId},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("tripletex: project: failed to link activity %d to %d: %w", activityId, projectId, err)
+	}
+	if err = checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return nil, fmt.Errorf("tripletex: project: failed to link activity %d to %d: %w", activityId, projectId, err)
+	}
+	if res.JSONDefault == nil || res.JSONDefault.Value == nil {
+		return nil, fmt.Errorf("tripletex: project: linked activity is empty")
+	}
+	return res.JSONDefault.Value, nil
+}
+
+// LinkActivityByName is like [ProjectService.LinkActivity] for the project
+// and activity with names or numbers, resolved with
+// [TripletexClient.Resolver].
+func (s *ProjectService) LinkActivityByName(ctx context.Context, project, activity string) (*ProjectActivity, error) {
+	projectId, err := s.client.Resolver().ProjectId(ctx, project)
+	if err != nil {
+		return nil, err
+	}
+	activityId, err := s.client.Resolver().ActivityId(ctx, activity)
+	if err != nil {
+		return nil, err
+	}
+	return s.LinkActivity(ctx, projectId, activityId)
+}
+
+// BudgetStatus returns the budget status of the project with id projectId.
+func (s *ProjectService) BudgetStatus(ctx context.Context, projectId int64) (*ProjectBudgetStatus, error) {
+	res, err := s.client.ProjectPeriodBudgetStatusGetBudgetStatusWithResponse(ctx, projectId, &ProjectPeriodBudgetStatusGetBudgetStatusParams{})
+	if err != nil {
+		return nil, fmt.Errorf("tripletex: project: failed to get budget status of %d: %w", projectId, err)
+	}
+	if err = checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return nil, fmt.Errorf("tripletex: project: failed to get budget status of %d: %w", projectId, err)
+	}
+	if res.JSONDefault == nil || res.JSONDefault.Value == nil {
+		return nil, fmt.Errorf("tripletex: project: budget status of %d is empty", projectId)
+	}
+	return res.JSONDefault.Value, nil
+}
+
+// Close closes the project with id projectId, setting its end date to endDate
+// if the project has none.
+func (s *ProjectService) Close(ctx context.Context, projectId int64, endDate time.Time) (*Project, error) {
+	f := "id,version,endDate"
+	getRes, err := s.client.ProjectGetWithResponse(ctx, projectId, &ProjectGetParams{Fields: &f})
+	if err != nil {
+		return nil, fmt.Errorf("tripletex: project: failed to get %d: %w", projectId, err)
+	}
+	if err = checkResponse(getRes.HTTPResponse, getRes.Body); err != nil {
+		return nil, fmt.Errorf("tripletex: project: failed to get %d: %w", projectId, err)
+	}
+	if getRes.JSONDefault == nil || getRes.JSONDefault.Value == nil {
+		return nil, fmt.Errorf("tripletex: project: %d is empty", projectId)
+	}
+
+	existing := getRes.JSONDefault.Value
+	closed := true
+	update := Project{Id: &projectId, Version: existing.Version, IsClosed: &closed}
+	if existing.EndDate == nil || *existing.EndDate == "" {
+		end := endDate.Format(time.DateOnly)
+		update.EndDate = &end
+	}
+
+	res, err := s.client.ProjectPutWithApplicationJSONCharsetUTF8BodyWithResponse(ctx, projectId, update)
+	if err != nil {
+		return nil, fmt.Errorf("tripletex: project: failed to close %d: %w", projectId, err)
+	}
+	if err = checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return nil, fmt.Errorf("tripletex: project: failed to close %d: %w", projectId, err)
+	}
+	if res.JSONDefault == nil || res.JSONDefault.Value == nil {
+		return nil, fmt.Errorf("tripletex: project: closed project %d is empty", projectId)
+	}
+	return res.JSONDefault.Value, nil
+}
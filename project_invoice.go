@@ -0,0 +1,248 @@
+package tripletex
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// ErrNothingToInvoice is returned by [ProjectService.GenerateInvoiceProposal]
+// when a project has no uninvoiced chargeable hours or order lines in the
+// period.
+var ErrNothingToInvoice = errors.New("tripletex: project: nothing to invoice")
+
+// ProjectInvoiceProposal is what can be invoiced on a project for a period,
+// for review before invoicing. See [ProjectService.InvoiceProposal] and
+// [ProjectService.GenerateInvoiceProposal].
+//
+// Amounts are excluding VAT, in the currency of the project.
+type ProjectInvoiceProposal struct {
+	ProjectId int64
+	DateFrom  time.Time
+	DateTo    time.Time
+
+	Hours      []TimesheetEntry   // Chargeable hours not yet invoiced
+	OrderLines []ProjectOrderLine // Chargeable expenses and fees not yet invoiced
+
+	ChargeableHours  float64 // Sum of chargeable hours of Hours
+	HoursAmount      float64 // Sum of chargeable hours times hourly rate of Hours
+	OrderLinesAmount float64 // Sum of OrderLines
+
+	// Reserve is the invoicing reserve of the period as calculated by
+	// Tripletex, including fixed price and akonto reserves.
+	Reserve *ProjectPeriodInvoicingReserve
+
+	// Order is the invoicing basis created by
+	// [ProjectService.GenerateInvoiceProposal], nil otherwise.
+	Order *Order
+}
+
+// Total returns the sum of the hours and order lines of p.
+func (p *ProjectInvoiceProposal) Total() float64 {
+	return p.HoursAmount + p.OrderLinesAmount
+}
+
+// InvoiceProposal gathers the uninvoiced chargeable hours and order lines of
+// the project with id projectId from dateFrom (inclusive) to dateTo
+// (exclusive), along with the invoicing reserve of the period.
+//
+// Nothing is created, see [ProjectService.GenerateInvoiceProposal] to also
+// create the invoicing basis.
+func (s *ProjectService) InvoiceProposal(ctx context.Context, projectId int64, dateFrom, dateTo time.Time) (*ProjectInvoiceProposal, error) {
+	proposal := &ProjectInvoiceProposal{ProjectId: projectId, DateFrom: dateFrom, DateTo: dateTo}
+	fromDate, toDate := dateFrom.Format(time.DateOnly), dateTo.Format(time.DateOnly)
+
+	project := strconv.FormatInt(projectId, 10)
+	entryFields := "id,date,hours,chargeable,chargeableHours,hourlyRate,comment,employee(id),activity(id,name),invoice(id)"
+	entries, err := collectPages(ctx, 0, func(ctx context.Context, from, count int) ([]TimesheetEntry, PageInfo, error) {
+		res, err := s.client.TimesheetEntrySearchSearchWithResponse(ctx, &TimesheetEntrySearchSearchParams{
+			ProjectId: &project,
+			DateFrom:  fromDate,
+			DateTo:    toDate,
+			From:      &from,
+			Count:     &count,
+			Fields:    &entryFields,
+		})
+		if err != nil {
+			return nil, PageInfo{}, fmt.Errorf("tripletex: project: failed to search hours of %d: %w", projectId, err)
+		}
+		if err = checkResponse(res.HTTPResponse, res.Body); err != nil {
+			return nil, PageInfo{}, fmt.Errorf("tripletex: project: failed to search hours of %d: %w", projectId, err)
+		}
+		if res.JSONDefault == nil {
+			return nil, PageInfo{}, nil
+		}
+		return listValues(res.JSONDefault.Values), res.JSONDefault.PageInfo(), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range entries {
+		if e.Invoice != nil || e.Chargeable == nil || !*e.Chargeable {
+			continue
+		}
+		proposal.Hours = append(proposal.Hours, e)
+		if e.ChargeableHours != nil {
+			proposal.ChargeableHours += float64(*e.ChargeableHours)
+			if e.HourlyRate != nil {
+				proposal.HoursAmount += float64(*e.ChargeableHours) * float64(*e.HourlyRate)
+			}
+		}
+	}
+
+	lineFields := "id,date,description,count,unitPriceExcludingVatCurrency,discount,amountExcludingVatCurrency,isChargeable,isBudget,product(id),vatType(id),invoice(id)"
+	lines, err := collectPages(ctx, 0, func(ctx context.Context, from, count int) ([]ProjectOrderLine, PageInfo, error) {
+		res, err := s.client.ProjectOrderlineSearchWithResponse(ctx, &ProjectOrderlineSearchParams{
+			ProjectId: projectId,
+			From:      &from,
+			Count:     &count,
+			Fields:    &lineFields,
+		})
+		if err != nil {
+			return nil, PageInfo{}, fmt.Errorf("tripletex: project: failed to search order lines of %d: %w", projectId, err)
+		}
+		if err = checkResponse(res.HTTPResponse, res.Body); err != nil {
+			return nil, PageInfo{}, fmt.Errorf("tripletex: project: failed to search order lines of %d: %w", projectId, err)
+		}
+		if res.JSONDefault == nil {
+			return nil, PageInfo{}, nil
+		}
+		return listValues(res.JSONDefault.Values), res.JSONDefault.PageInfo(), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, l := range lines {
+		if l.Invoice != nil || (l.IsBudget != nil && *l.IsBudget) || (l.IsChargeable != nil && !*l.IsChargeable) {
+			continue
+		}
+		if !dateInRange(l.Date, fromDate, toDate) {
+			continue
+		}
+		proposal.OrderLines = append(proposal.OrderLines, l)
+		if l.AmountExcludingVatCurrency != nil {
+			proposal.OrderLinesAmount += float64(*l.AmountExcludingVatCurrency)
+		}
+	}
+
+	res, err := s.client.ProjectPeriodInvoicingReserveInvoicingReserveWithResponse(ctx, projectId, &ProjectPeriodInvoicingReserveInvoicingReserveParams{
+		DateFrom: fromDate,
+		DateTo:   toDate,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("tripletex: project: failed to get invoicing reserve of %d: %w", projectId, err)
+	}
+	if err = checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return nil, fmt.Errorf("tripletex: project: failed to get invoicing reserve of %d: %w", projectId, err)
+	}
+	if res.JSONDefault != nil {
+		proposal.Reserve = res.JSONDefault.Value
+	}
+	return proposal, nil
+}
+
+// GenerateInvoiceProposal gathers the proposal like
+// [ProjectService.InvoiceProposal], and creates the invoicing basis: an order
+// for the customer of the project, linked to it and dated the last day of the
+// period. Review it before invoicing it with [TripletexClient.InvoiceOrder].
+//
+// The order has a line per activity and hourly rate of the hours, and a line
+// per order line of the proposal. Returns [ErrNothingToInvoice] if there are
+// neither, and error if the project has no customer.
+func (s *ProjectService) GenerateInvoiceProposal(ctx context.Context, projectId int64, dateFrom, dateTo time.Time) (*ProjectInvoiceProposal, error) {
+	proposal, err := s.InvoiceProposal(ctx, projectId, dateFrom, dateTo)
+	if err != nil {
+		return nil, err
+	}
+	lines := invoiceBasisLines(proposal)
+	if len(lines) == 0 {
+		return proposal, fmt.Errorf("%w: project %d from %s to %s", ErrNothingToInvoice, projectId, dateFrom.Format(time.DateOnly), dateTo.Format(time.DateOnly))
+	}
+
+	f := "id,customer(id)"
+	projectRes, err := s.client.ProjectGetWithResponse(ctx, projectId, &ProjectGetParams{Fields: &f})
+	if err != nil {
+		return proposal, fmt.Errorf("tripletex: project: failed to get %d: %w", projectId, err)
+	}
+	if err = checkResponse(projectRes.HTTPResponse, projectRes.Body); err != nil {
+		return proposal, fmt.Errorf("tripletex: project: failed to get %d: %w", projectId, err)
+	}
+	if projectRes.JSONDefault == nil || projectRes.JSONDefault.Value == nil || projectRes.JSONDefault.Value.Customer == nil || projectRes.JSONDefault.Value.Customer.Id == nil {
+		return proposal, fmt.Errorf("tripletex: project: %d has no customer to invoice", projectId)
+	}
+
+	date := dateTo.AddDate(0, 0, -1).Format(time.DateOnly)
+	res, err := s.client.OrderPostWithApplicationJSONCharsetUTF8BodyWithResponse(ctx, Order{
+		Customer:     &Customer{Id: projectRes.JSONDefault.Value.Customer.Id},
+		Project:      &Project{Id: &projectId},
+		OrderDate:    &date,
+		DeliveryDate: &date,
+		OrderLines:   &lines,
+	})
+	if err != nil {
+		return proposal, fmt.Errorf("tripletex: project: failed to create invoicing basis of %d: %w", projectId, err)
+	}
+	if err = checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return proposal, fmt.Errorf("tripletex: project: failed to create invoicing basis of %d: %w", projectId, err)
+	}
+	if res.JSONDefault == nil || res.JSONDefault.Value == nil {
+		return proposal, fmt.Errorf("tripletex: project: created invoicing basis of %d is empty", projectId)
+	}
+	proposal.Order = res.JSONDefault.Value
+	return proposal, nil
+}
+
+// invoiceBasisLines returns the order lines invoicing proposal: the hours
+// summed per activity and hourly rate, in order of appearance, followed by
+// the order lines.
+func invoiceBasisLines(proposal *ProjectInvoiceProposal) []OrderLine {
+	type hoursKey struct {
+		activityId int64
+		rate       float32
+	}
+	var lines []OrderLine
+	byKey := make(map[hoursKey]int)
+	for _, e := range proposal.Hours {
+		if e.ChargeableHours == nil || *e.ChargeableHours == 0 {
+			continue
+		}
+		var key hoursKey
+		description := "Hours"
+		if e.Activity != nil && e.Activity.Id != nil {
+			key.activityId = *e.Activity.Id
+			if e.Activity.Name != nil {
+				description = *e.Activity.Name
+			}
+		}
+		if e.HourlyRate != nil {
+			key.rate = *e.HourlyRate
+		}
+
+		i, ok := byKey[key]
+		if !ok {
+			i = len(lines)
+			byKey[key] = i
+			count, rate := float32(0), key.rate
+			lines = append(lines, OrderLine{
+				Description:                   &description,
+				Count:                         &count,
+				UnitPriceExcludingVatCurrency: &rate,
+			})
+		}
+		*lines[i].Count += *e.ChargeableHours
+	}
+
+	for _, l := range proposal.OrderLines {
+		lines = append(lines, OrderLine{
+			Description:                   l.Description,
+			Count:                         l.Count,
+			UnitPriceExcludingVatCurrency: l.UnitPriceExcludingVatCurrency,
+			Discount:                      l.Discount,
+			Product:                       l.Product,
+			VatType:                       l.VatType,
+		})
+	}
+	return lines
+}
@@ -0,0 +1,123 @@
+package tripletex
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// projectInvoiceMux serves the hours, order lines and invoicing reserve of
+// project 5 in March 2025.
+func projectInvoiceMux(t *testing.T) *http.ServeMux {
+	require := require.New(t)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /timesheet/entry", func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		require.Equal("5", q.Get("projectId"))
+		require.Equal("2025-03-01", q.Get("dateFrom"))
+		require.Equal("2025-04-01", q.Get("dateTo"))
+		writeTestJSON(w, http.StatusOK, ListResponseTimesheetEntry{Values: &[]TimesheetEntry{
+			{Id: ptr(int64(1)), Chargeable: ptr(true), ChargeableHours: ptr(float32(2)), HourlyRate: ptr(float32(1000)), Activity: &Activity{Id: ptr(int64(20)), Name: ptr("Development")}},
+			{Id: ptr(int64(2)), Chargeable: ptr(true), ChargeableHours: ptr(float32(3)), HourlyRate: ptr(float32(1000)), Invoice: &Invoice{Id: ptr(int64(9))}},
+			{Id: ptr(int64(3)), Chargeable: ptr(false), Hours: ptr(float32(4))},
+		}})
+	})
+	mux.HandleFunc("GET /project/orderline", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal("5", r.URL.Query().Get("projectId"))
+		writeTestJSON(w, http.StatusOK, ListResponseProjectOrderLine{Values: &[]ProjectOrderLine{
+			{Id: ptr(int64(10)), Date: ptr("2025-03-10"), Description: ptr("Travel"), Count: ptr(float32(1)), UnitPriceExcludingVatCurrency: ptr(float32(500)), AmountExcludingVatCurrency: ptr(float32(500))},
+			{Id: ptr(int64(11)), Date: ptr("2025-04-01"), AmountExcludingVatCurrency: ptr(float32(700))},
+			{Id: ptr(int64(12)), Date: ptr("2025-03-10"), AmountExcludingVatCurrency: ptr(float32(900)), IsBudget: ptr(true)},
+			{Id: ptr(int64(13)), Date: ptr("2025-03-10"), AmountExcludingVatCurrency: ptr(float32(300)), Invoice: &Invoice{Id: ptr(int64(9))}},
+		}})
+	})
+	mux.HandleFunc("GET /project/{id}/period/invoicingReserve", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal("5", r.PathValue("id"))
+		writeTestJSON(w, http.StatusOK, ResponseWrapperProjectPeriodInvoicingReserve{Value: &ProjectPeriodInvoicingReserve{
+			InvoiceReserveTotalAmountCurrency: ptr(float32(2500)),
+		}})
+	})
+	return mux
+}
+
+func TestProjectInvoiceProposal(t *testing.T) {
+	require := require.New(t)
+
+	c := newTestClient(t, projectInvoiceMux(t))
+
+	proposal, err := c.Projects().InvoiceProposal(context.Background(), 5,
+		time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC))
+	require.NoError(err)
+	require.Len(proposal.Hours, 1)
+	require.Equal(int64(1), *proposal.Hours[0].Id)
+	require.Equal(2.0, proposal.ChargeableHours)
+	require.Equal(2000.0, proposal.HoursAmount)
+	require.Len(proposal.OrderLines, 1)
+	require.Equal(int64(10), *proposal.OrderLines[0].Id)
+	require.Equal(2500.0, proposal.Total())
+	require.Equal(float32(2500), *proposal.Reserve.InvoiceReserveTotalAmountCurrency)
+}
+
+func TestProjectGenerateInvoiceProposal(t *testing.T) {
+	require := require.New(t)
+
+	mux := projectInvoiceMux(t)
+	mux.HandleFunc("GET /project/{id}", func(w http.ResponseWriter, r *http.Request) {
+		writeTestJSON(w, http.StatusOK, ResponseWrapperProject{Value: &Project{Id: ptr(int64(5)), Customer: &Customer{Id: ptr(int64(3))}}})
+	})
+	var created Order
+	mux.HandleFunc("POST /order", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(json.NewDecoder(r.Body).Decode(&created))
+		created.Id = ptr(int64(30))
+		writeTestJSON(w, http.StatusCreated, ResponseWrapperOrder{Value: &created})
+	})
+	c := newTestClient(t, mux)
+
+	proposal, err := c.Projects().GenerateInvoiceProposal(context.Background(), 5,
+		time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC))
+	require.NoError(err)
+	require.Equal(int64(30), *proposal.Order.Id)
+	require.Equal(2500.0, proposal.Total())
+
+	require.Equal(int64(3), *created.Customer.Id)
+	require.Equal(int64(5), *created.Project.Id)
+	require.Equal("2025-03-31", *created.OrderDate)
+	lines := *created.OrderLines
+	require.Len(lines, 2)
+	require.Equal("Development", *lines[0].Description)
+	require.Equal(float32(2), *lines[0].Count)
+	require.Equal(float32(1000), *lines[0].UnitPriceExcludingVatCurrency)
+	require.Equal("Travel", *lines[1].Description)
+	require.Equal(float32(500), *lines[1].UnitPriceExcludingVatCurrency)
+}
+
+func TestProjectGenerateInvoiceProposalNothingToInvoice(t *testing.T) {
+	require := require.New(t)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /timesheet/entry", func(w http.ResponseWriter, r *http.Request) {
+		writeTestJSON(w, http.StatusOK, ListResponseTimesheetEntry{})
+	})
+	mux.HandleFunc("GET /project/orderline", func(w http.ResponseWriter, r *http.Request) {
+		writeTestJSON(w, http.StatusOK, ListResponseProjectOrderLine{})
+	})
+	mux.HandleFunc("GET /project/{id}/period/invoicingReserve", func(w http.ResponseWriter, r *http.Request) {
+		writeTestJSON(w, http.StatusOK, ResponseWrapperProjectPeriodInvoicingReserve{})
+	})
+	mux.HandleFunc("POST /order", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("should not create an order")
+	})
+	c := newTestClient(t, mux)
+
+	_, err := c.Projects().GenerateInvoiceProposal(context.Background(), 5,
+		time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC))
+	require.ErrorIs(err, ErrNothingToInvoice)
+}
@@ -0,0 +1,102 @@
+package tripletex
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestProjectCreateWithParticipants(t *testing.T) {
+	require := require.New(t)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /project", func(w http.ResponseWriter, r *http.Request) {
+		var p Project
+		require.NoError(json.NewDecoder(r.Body).Decode(&p))
+		p.Id = ptr(int64(11))
+		writeTestJSON(w, http.StatusCreated, ResponseWrapperProject{Value: &p})
+	})
+	mux.HandleFunc("POST /project/participant/list", func(w http.ResponseWriter, r *http.Request) {
+		var participants []ProjectParticipant
+		require.NoError(json.NewDecoder(r.Body).Decode(&participants))
+		require.Len(participants, 2)
+		for _, p := range participants {
+			require.Equal(int64(11), *p.Project.Id)
+		}
+		writeTestJSON(w, http.StatusCreated, ListResponseProjectParticipant{Values: &participants})
+	})
+	c := newTestClient(t, mux)
+
+	project, err := c.Projects().Create(context.Background(), Project{Name: ptr("Website")}, 1, 2)
+	require.NoError(err)
+	require.Equal(int64(11), *project.Id)
+	require.Len(*project.Participants, 2)
+}
+
+func TestProjectClose(t *testing.T) {
+	require := require.New(t)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /project/{id}", func(w http.ResponseWriter, r *http.Request) {
+		writeTestJSON(w, http.StatusOK, ResponseWrapperProject{Value: &Project{Id: ptr(int64(11)), Version: ptr(int32(4))}})
+	})
+	mux.HandleFunc("PUT /project/{id}", func(w http.ResponseWriter, r *http.Request) {
+		var p Project
+		require.NoError(json.NewDecoder(r.Body).Decode(&p))
+		require.True(*p.IsClosed)
+		require.Equal(int32(4), *p.Version)
+		require.Equal("2025-06-30", *p.EndDate)
+		writeTestJSON(w, http.StatusOK, ResponseWrapperProject{Value: &p})
+	})
+	c := newTestClient(t, mux)
+
+	project, err := c.Projects().Close(context.Background(), 11, time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC))
+	require.NoError(err)
+	require.True(*project.IsClosed)
+}
+
+func TestProjectByName(t *testing.T) {
+	require := require.New(t)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /project", func(w http.ResponseWriter, r *http.Request) {
+		writeTestJSON(w, http.StatusOK, ListResponseProject{Values: &[]Project{{Id: ptr(int64(11)), Number: ptr("P11"), Name: ptr("Website")}}})
+	})
+	mux.HandleFunc("GET /activity", func(w http.ResponseWriter, r *http.Request) {
+		writeTestJSON(w, http.StatusOK, ListResponseActivity{Values: &[]Activity{{Id: ptr(int64(3)), Name: ptr("Development")}}})
+	})
+	mux.HandleFunc("GET /employee", func(w http.ResponseWriter, r *http.Request) {
+		writeTestJSON(w, http.StatusOK, ListResponseEmployee{Values: &[]Employee{
+			{Id: ptr(int64(1)), EmployeeNumber: ptr("E1")},
+			{Id: ptr(int64(2)), EmployeeNumber: ptr("E2")},
+		}})
+	})
+	mux.HandleFunc("POST /project/participant/list", func(w http.ResponseWriter, r *http.Request) {
+		var participants []ProjectParticipant
+		require.NoError(json.NewDecoder(r.Body).Decode(&participants))
+		require.Len(participants, 2)
+		require.Equal(int64(11), *participants[0].Project.Id)
+		require.Equal(int64(2), *participants[1].Employee.Id)
+		writeTestJSON(w, http.StatusCreated, ListResponseProjectParticipant{Values: &participants})
+	})
+	mux.HandleFunc("POST /project/projectActivity", func(w http.ResponseWriter, r *http.Request) {
+		var pa ProjectActivity
+		require.NoError(json.NewDecoder(r.Body).Decode(&pa))
+		require.Equal(int64(11), *pa.Project.Id)
+		require.Equal(int64(3), *pa.Activity.Id)
+		writeTestJSON(w, http.StatusCreated, ResponseWrapperProjectActivity{Value: &pa})
+	})
+	c := newTestClient(t, mux)
+	ctx := context.Background()
+
+	participants, err := c.Projects().AddParticipantsByName(ctx, "Website", false, "E1", "E2")
+	require.NoError(err)
+	require.Len(participants, 2)
+
+	_, err = c.Projects().LinkActivityByName(ctx, "P11", "Development")
+	require.NoError(err)
+}
@@ -0,0 +1,267 @@
+package tripletex
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+)
+
+// ReconciliationService groups the bank reconciliation endpoints.
+//
+// Use [TripletexClient.Reconciliation] to get one.
+type ReconciliationService struct {
+	client *TripletexClient
+}
+
+// Reconciliation returns a [ReconciliationService] using c.
+func (c *TripletexClient) Reconciliation() *ReconciliationService {
+	return &ReconciliationService{client: c}
+}
+
+// UnmatchedEntries are the bank transactions and ledger postings of a bank
+// reconciliation that are not part of any match.
+type UnmatchedEntries struct {
+	Transactions []BankTransaction
+	Postings     []Posting
+}
+
+// Get returns the bank reconciliation with id.
+func (s *ReconciliationService) Get(ctx context.Context, id int64) (*BankReconciliation, error) {
+	f := "*,account(id),accountingPeriod(id,start,end),transactions(*)"
+	res, err := s.client.BankReconciliationGetWithResponse(ctx, id, &BankReconciliationGetParams{Fields: &f})
+	if err != nil {
+		return nil, fmt.Errorf("tripletex: reconciliation: failed to get %d: %w", id, err)
+	}
+	if err = checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return nil, fmt.Errorf("tripletex: reconciliation: failed to get %d: %w", id, err)
+	}
+	if res.JSONDefault == nil || res.JSONDefault.Value == nil {
+		return nil, fmt.Errorf("tripletex: reconciliation: %d is empty", id)
+	}
+	return res.JSONDefault.Value, nil
+}
+
+// Matches returns all matches of the bank reconciliation with id
+// reconciliationId, including suggestions.
+func (s *ReconciliationService) Matches(ctx context.Context, reconciliationId int64) ([]BankReconciliationMatch, error) {
+	id := strconv.FormatInt(reconciliationId, 10)
+	f := "id,type,transactions(id),postings(id)"
+	return collectPages(ctx, 0, func(ctx context.Context, from, count int) ([]BankReconciliationMatch, PageInfo, error) {
+		count32 := int32(count)
+		res, err := s.client.BankReconciliationMatchSearchWithResponse(ctx, &BankReconciliationMatchSearchParams{
+			BankReconciliationId: &id,
+			From:                 &from,
+			Count:                &count32,
+			Fields:               &f,
+		})
+		if err != nil {
+			return nil, PageInfo{}, fmt.Errorf("tripletex: reconciliation: failed to search matches: %w", err)
+		}
+		if err = checkResponse(res.HTTPResponse, res.Body); err != nil {
+			return nil, PageInfo{}, fmt.Errorf("tripletex: reconciliation: failed to search matches: %w", err)
+		}
+		if res.JSONDefault == nil {
+			return nil, PageInfo{}, nil
+		}
+		return listValues(res.JSONDefault.Values), res.JSONDefault.PageInfo(), nil
+	})
+}
+
+// Unmatched returns the bank transactions and ledger postings of the bank
+// reconciliation with id reconciliationId that are not part of a match.
+//
+// Pending and rejected suggestions are not considered matches.
+func (s *ReconciliationService) Unmatched(ctx context.Context, reconciliationId int64) (*UnmatchedEntries, error) {
+	reconciliation, err := s.Get(ctx, reconciliationId)
+	if err != nil {
+		return nil, err
+	}
+	matches, err := s.Matches(ctx, reconciliationId)
+	if err != nil {
+		return nil, err
+	}
+
+	matchedTransactions := make(map[int64]bool)
+	matchedPostings := make(map[int64]bool)
+	for _, m := range matches {
+		if !isReconciledMatch(m) {
+			continue
+		}
+		for _, t := range listValues(m.Transactions) {
+			if t.Id != nil {
+				matchedTransactions[*t.Id] = true
+			}
+		}
+		for _, p := range listValues(m.Postings) {
+			if p.Id != nil {
+				matchedPostings[*p.Id] = true
+			}
+		}
+	}
+
+	unmatched := &UnmatchedEntries{}
+	for _, t := range listValues(reconciliation.Transactions) {
+		if t.Id == nil || matchedTransactions[*t.Id] || (t.Matched != nil && *t.Matched) {
+			continue
+		}
+		unmatched.Transactions = append(unmatched.Transactions, t)
+	}
+
+	period := reconciliation.AccountingPeriod
+	if reconciliation.Account == nil || reconciliation.Account.Id == nil || period == nil || period.Start == nil || period.End == nil {
+		return nil, fmt.Errorf("tripletex: reconciliation: %d is missing account or accounting period", reconciliationId)
+	}
+	postings, err := collectPages(ctx, 0, func(ctx context.Context, from, count int) ([]Posting, PageInfo, error) {
+		res, err := s.client.LedgerPostingSearchWithResponse(ctx, &LedgerPostingSearchParams{
+			AccountId: reconciliation.Account.Id,
+			DateFrom:  *period.Start,
+			DateTo:    *period.End,
+			From:      &from,
+			Count:     &count,
+		})
+		if err != nil {
+			return nil, PageInfo{}, fmt.Errorf("tripletex: reconciliation: failed to search postings: %w", err)
+		}
+		if err = checkResponse(res.HTTPResponse, res.Body); err != nil {
+			return nil, PageInfo{}, fmt.Errorf("tripletex: reconciliation: failed to search postings: %w", err)
+		}
+		if res.JSONDefault == nil {
+			return nil, PageInfo{}, nil
+		}
+		return listValues(res.JSONDefault.Values), res.JSONDefault.PageInfo(), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range postings {
+		if p.Id == nil || matchedPostings[*p.Id] {
+			continue
+		}
+		unmatched.Postings = append(unmatched.Postings, p)
+	}
+
+	return unmatched, nil
+}
+
+// isReconciledMatch returns true if m counts towards the reconciliation.
+func isReconciledMatch(m BankReconciliationMatch) bool {
+	if m.Type == nil {
+		return true
+	}
+	switch *m.Type {
+	case BankReconciliationMatchTypePENDINGSUGGESTION,
+		BankReconciliationMatchTypeREJECTEDAUTOMATCH,
+		BankReconciliationMatchTypeREJECTEDSUGGESTION:
+		return false
+	}
+	return true
+}
+
+// Match creates a manual match between the bank transactions and ledger
+// postings with the given ids.
+func (s *ReconciliationService) Match(ctx context.Context, reconciliationId int64, transactionIds, postingIds []int64) (*BankReconciliationMatch, error) {
+	transactions := make([]BankTransaction, len(transactionIds))
+	for i, id := range transactionIds {
+		transactions[i] = BankTransaction{Id: &id}
+	}
+	postings := make([]Posting, len(postingIds))
+	for i, id := range postingIds {
+		postings[i] = Posting{Id: &id}
+	}
+	matchType := BankReconciliationMatchTypeMANUAL
+
+	res, err := s.client.BankReconciliationMatchPostWithApplicationJSONCharsetUTF8BodyWithResponse(ctx, BankReconciliationMatch{
+		BankReconciliation: &BankReconciliation{Id: &reconciliationId},
+		Transactions:       &transactions,
+		Postings:           &postings,
+		Type:               &matchType,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("tripletex: reconciliation: failed to create match: %w", err)
+	}
+	if err = checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return nil, fmt.Errorf("tripletex: reconciliation: failed to create match: %w", err)
+	}
+	if res.JSONDefault == nil || res.JSONDefault.Value == nil {
+		return nil, fmt.Errorf("tripletex: reconciliation: created match is empty")
+	}
+	return res.JSONDefault.Value, nil
+}
+
+// Unmatch deletes the match with id matchId.
+func (s *ReconciliationService) Unmatch(ctx context.Context, matchId int64) error {
+	res, err := s.client.BankReconciliationMatchDeleteWithResponse(ctx, matchId)
+	if err != nil {
+		return fmt.Errorf("tripletex: reconciliation: failed to delete match %d: %w", matchId, err)
+	}
+	if err = checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return fmt.Errorf("tripletex: reconciliation: failed to delete match %d: %w", matchId, err)
+	}
+	return nil
+}
+
+// Suggest asks Tripletex to suggest matches for the bank reconciliation with id
+// reconciliationId, and returns the suggestions.
+func (s *ReconciliationService) Suggest(ctx context.Context, reconciliationId int64) ([]BankReconciliationMatch, error) {
+	res, err := s.client.BankReconciliationMatchSuggestSuggestWithResponse(ctx, &BankReconciliationMatchSuggestSuggestParams{
+		BankReconciliationId: reconciliationId,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("tripletex: reconciliation: failed to suggest matches: %w", err)
+	}
+	if err = checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return nil, fmt.Errorf("tripletex: reconciliation: failed to suggest matches: %w", err)
+	}
+	if res.JSONDefault == nil {
+		return nil, nil
+	}
+	return listValues(res.JSONDefault.Values), nil
+}
+
+// AddAdjustments adds adjustments (eg. bank fees) to the bank reconciliation
+// with id reconciliationId.
+func (s *ReconciliationService) AddAdjustments(ctx context.Context, reconciliationId int64, adjustments []BankReconciliationAdjustment) ([]BankReconciliationAdjustment, error) {
+	res, err := s.client.BankReconciliationAdjustmentAdjustmentWithApplicationJSONCharsetUTF8BodyWithResponse(ctx, reconciliationId, adjustments)
+	if err != nil {
+		return nil, fmt.Errorf("tripletex: reconciliation: failed to add adjustments: %w", err)
+	}
+	if err = checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return nil, fmt.Errorf("tripletex: reconciliation: failed to add adjustments: %w", err)
+	}
+	if res.JSONDefault == nil {
+		return nil, nil
+	}
+	return listValues(res.JSONDefault.Values), nil
+}
+
+// Close closes the bank reconciliation with id reconciliationId.
+func (s *ReconciliationService) Close(ctx context.Context, reconciliationId int64) (*BankReconciliation, error) {
+	f := "id,version"
+	getRes, err := s.client.BankReconciliationGetWithResponse(ctx, reconciliationId, &BankReconciliationGetParams{Fields: &f})
+	if err != nil {
+		return nil, fmt.Errorf("tripletex: reconciliation: failed to get %d: %w", reconciliationId, err)
+	}
+	if err = checkResponse(getRes.HTTPResponse, getRes.Body); err != nil {
+		return nil, fmt.Errorf("tripletex: reconciliation: failed to get %d: %w", reconciliationId, err)
+	}
+	if getRes.JSONDefault == nil || getRes.JSONDefault.Value == nil {
+		return nil, fmt.Errorf("tripletex: reconciliation: %d is empty", reconciliationId)
+	}
+
+	closed := true
+	res, err := s.client.BankReconciliationPutWithApplicationJSONCharsetUTF8BodyWithResponse(ctx, reconciliationId, BankReconciliation{
+		Id:       &reconciliationId,
+		Version:  getRes.JSONDefault.Value.Version,
+		IsClosed: &closed,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("tripletex: reconciliation: failed to close %d: %w", reconciliationId, err)
+	}
+	if err = checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return nil, fmt.Errorf("tripletex: reconciliation: failed to close %d: %w", reconciliationId, err)
+	}
+	if res.JSONDefault == nil || res.JSONDefault.Value == nil {
+		return nil, fmt.Errorf("tripletex: reconciliation: closed %d is empty", reconciliationId)
+	}
+	return res.JSONDefault.Value, nil
+}
@@ -0,0 +1,54 @@
+package tripletex
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReconciliationUnmatched(t *testing.T) {
+	require := require.New(t)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /bank/reconciliation/{id}", func(w http.ResponseWriter, r *http.Request) {
+		writeTestJSON(w, http.StatusOK, ResponseWrapperBankReconciliation{Value: &BankReconciliation{
+			Id:               ptr(int64(1)),
+			Account:          &Account{Id: ptr(int64(1920))},
+			AccountingPeriod: &AccountingPeriod{Start: ptr("2025-01-01"), End: ptr("2025-02-01")},
+			Transactions: &[]BankTransaction{
+				{Id: ptr(int64(10))},
+				{Id: ptr(int64(11))},
+				{Id: ptr(int64(12)), Matched: ptr(true)},
+				{Id: ptr(int64(13))},
+			},
+		}})
+	})
+	mux.HandleFunc("GET /bank/reconciliation/match", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal("1", r.URL.Query().Get("bankReconciliationId"))
+		manual := BankReconciliationMatchTypeMANUAL
+		pending := BankReconciliationMatchTypePENDINGSUGGESTION
+		writeTestJSON(w, http.StatusOK, ListResponseBankReconciliationMatch{Values: &[]BankReconciliationMatch{
+			{Type: &manual, Transactions: &[]BankTransaction{{Id: ptr(int64(10))}}, Postings: &[]Posting{{Id: ptr(int64(100))}}},
+			{Type: &pending, Transactions: &[]BankTransaction{{Id: ptr(int64(11))}}, Postings: &[]Posting{{Id: ptr(int64(101))}}},
+		}})
+	})
+	mux.HandleFunc("GET /ledger/posting", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal("1920", r.URL.Query().Get("accountId"))
+		require.Equal("2025-01-01", r.URL.Query().Get("dateFrom"))
+		writeTestJSON(w, http.StatusOK, ListResponsePosting{Values: &[]Posting{
+			{Id: ptr(int64(100))},
+			{Id: ptr(int64(101))},
+		}})
+	})
+	c := newTestClient(t, mux)
+
+	unmatched, err := c.Reconciliation().Unmatched(context.Background(), 1)
+	require.NoError(err)
+	require.Len(unmatched.Transactions, 2)
+	require.Equal(int64(11), *unmatched.Transactions[0].Id)
+	require.Equal(int64(13), *unmatched.Transactions[1].Id)
+	require.Len(unmatched.Postings, 1)
+	require.Equal(int64(101), *unmatched.Postings[0].Id)
+}
@@ -0,0 +1,248 @@
+package tripletex
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrNotFound is returned when looking up a resource that doesn't exist.
+var ErrNotFound = errors.New("tripletex: not found")
+
+// defaultRefDataTTL is the default time reference data is cached.
+const defaultRefDataTTL = time.Hour
+
+// WithRefDataTTL sets how long reference data is cached by [RefData] and
+// [Resolver]. Defaults to one hour.
+func WithRefDataTTL(ttl time.Duration) Option {
+	return func(tc *TripletexClient) {
+		tc.refDataTTL = ttl
+	}
+}
+
+// RefData caches reference data that rarely changes, like VAT types, ledger
+// accounts, currencies and payment types, which are needed by most write
+// operations.
+//
+// Each list is loaded on first use and reloaded when older than the TTL set
+// by [WithRefDataTTL]. It's safe for concurrent use.
+//
+// Use [TripletexClient.RefData] to get one.
+type RefData struct {
+	client *TripletexClient
+	ttl    time.Duration
+
+	vatTypes       refCache[VatType]
+	accounts       refCache[Account]
+	currencies     refCache[Currency]
+	paymentTypes   refCache[PaymentType]
+	countries      refCache[Country]
+	municipalities refCache[Municipality]
+
+	mu             sync.Mutex
+	vatTypesByDate map[string]*refCache[VatType] // Valid VAT types by date
+	rates          map[rateKey]cachedRate
+}
+
+// RefData returns the [RefData] of c.
+func (c *TripletexClient) RefData() *RefData {
+	return c.refData
+}
+
+// refCache is a lazily loaded list with a load time.
+type refCache[T any] struct {
+	mu       sync.Mutex
+	values   []T
+	loadedAt time.Time
+}
+
+// get returns the cached list, loading it with fetch if it's missing or older
+// than ttl. Concurrent callers wait for a single load.
+func (c *refCache[T]) get(ctx context.Context, ttl time.Duration, fetch pageFunc[T]) ([]T, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.values != nil && time.Since(c.loadedAt) < ttl {
+		return c.values, nil
+	}
+
+	values, err := collectPages(ctx, 0, fetch)
+	if err != nil {
+		return nil, err
+	}
+	if values == nil {
+		values = []T{}
+	}
+	c.values = values
+	c.loadedAt = time.Now()
+	return values, nil
+}
+
+// invalidate drops the cached list.
+func (c *refCache[T]) invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.values = nil
+}
+
+// findRef returns a copy of the first value in values matching match, so the
+// cached list can't be modified through it.
+func findRef[T any](values []T, match func(T) bool) (*T, bool) {
+	for _, v := range values {
+		if match(v) {
+			return &v, true
+		}
+	}
+	return nil, false
+}
+
+// Invalidate drops all cached reference data, so it's reloaded on next use.
+func (r *RefData) Invalidate() {
+	r.vatTypes.invalidate()
+	r.accounts.invalidate()
+	r.currencies.invalidate()
+	r.paymentTypes.invalidate()
+	r.countries.invalidate()
+	r.municipalities.invalidate()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.vatTypesByDate = nil
+	r.rates = nil
+}
+
+// VatTypes returns all VAT types.
+func (r *RefData) VatTypes(ctx context.Context) ([]VatType, error) {
+	return r.vatTypes.get(ctx, r.ttl, func(ctx context.Context, from, count int) ([]VatType, PageInfo, error) {
+		res, err := r.client.LedgerVatTypeSearchWithResponse(ctx, &LedgerVatTypeSearchParams{
+			From:  &from,
+			Count: &count,
+		})
+		if err != nil {
+			return nil, PageInfo{}, fmt.Errorf("tripletex: refdata: failed to search vat types: %w", err)
+		}
+		if err = checkResponse(res.HTTPResponse, res.Body); err != nil {
+			return nil, PageInfo{}, fmt.Errorf("tripletex: refdata: failed to search vat types: %w", err)
+		}
+		if res.JSONDefault == nil {
+			return nil, PageInfo{}, nil
+		}
+		return listValues(res.JSONDefault.Values), res.JSONDefault.PageInfo(), nil
+	})
+}
+
+// VatTypeByNumber returns the VAT type with number, eg. "3".
+func (r *RefData) VatTypeByNumber(ctx context.Context, number string) (*VatType, error) {
+	vatTypes, err := r.VatTypes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if v, ok := findRef(vatTypes, func(v VatType) bool { return v.Number != nil && *v.Number == number }); ok {
+		return v, nil
+	}
+	return nil, fmt.Errorf("%w: vat type %q", ErrNotFound, number)
+}
+
+// Accounts returns all ledger accounts.
+func (r *RefData) Accounts(ctx context.Context) ([]Account, error) {
+	return r.accounts.get(ctx, r.ttl, func(ctx context.Context, from, count int) ([]Account, PageInfo, error) {
+		res, err := r.client.LedgerAccountSearchWithResponse(ctx, &LedgerAccountSearchParams{
+			From:  &from,
+			Count: &count,
+		})
+		if err != nil {
+			return nil, PageInfo{}, fmt.Errorf("tripletex: refdata: failed to search accounts: %w", err)
+		}
+		if err = checkResponse(res.HTTPResponse, res.Body); err != nil {
+			return nil, PageInfo{}, fmt.Errorf("tripletex: refdata: failed to search accounts: %w", err)
+		}
+		if res.JSONDefault == nil {
+			return nil, PageInfo{}, nil
+		}
+		return listValues(res.JSONDefault.Values), res.JSONDefault.PageInfo(), nil
+	})
+}
+
+// AccountByNumber returns the ledger account with number, eg. 3000.
+func (r *RefData) AccountByNumber(ctx context.Context, number int32) (*Account, error) {
+	accounts, err := r.Accounts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if a, ok := findRef(accounts, func(a Account) bool { return a.Number != nil && *a.Number == number }); ok {
+		return a, nil
+	}
+	return nil, fmt.Errorf("%w: account %d", ErrNotFound, number)
+}
+
+// Currencies returns all currencies.
+func (r *RefData) Currencies(ctx context.Context) ([]Currency, error) {
+	return r.currencies.get(ctx, r.ttl, func(ctx context.Context, from, count int) ([]Currency, PageInfo, error) {
+		res, err := r.client.CurrencySearchWithResponse(ctx, &CurrencySearchParams{
+			From:  &from,
+			Count: &count,
+		})
+		if err != nil {
+			return nil, PageInfo{}, fmt.Errorf("tripletex: refdata: failed to search currencies: %w", err)
+		}
+		if err = checkResponse(res.HTTPResponse, res.Body); err != nil {
+			return nil, PageInfo{}, fmt.Errorf("tripletex: refdata: failed to search currencies: %w", err)
+		}
+		if res.JSONDefault == nil {
+			return nil, PageInfo{}, nil
+		}
+		return listValues(res.JSONDefault.Values), res.JSONDefault.PageInfo(), nil
+	})
+}
+
+// CurrencyByCode returns the currency with ISO 4217 code, eg. "NOK". The code
+// is matched case-insensitively.
+func (r *RefData) CurrencyByCode(ctx context.Context, code string) (*Currency, error) {
+	currencies, err := r.Currencies(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if c, ok := findRef(currencies, func(c Currency) bool { return c.Code != nil && strings.EqualFold(*c.Code, code) }); ok {
+		return c, nil
+	}
+	return nil, fmt.Errorf("%w: currency %q", ErrNotFound, code)
+}
+
+// PaymentTypes returns all payment types for incoming invoice payments.
+func (r *RefData) PaymentTypes(ctx context.Context) ([]PaymentType, error) {
+	return r.paymentTypes.get(ctx, r.ttl, func(ctx context.Context, from, count int) ([]PaymentType, PageInfo, error) {
+		res, err := r.client.InvoicePaymentTypeSearchWithResponse(ctx, &InvoicePaymentTypeSearchParams{
+			From:  &from,
+			Count: &count,
+		})
+		if err != nil {
+			return nil, PageInfo{}, fmt.Errorf("tripletex: refdata: failed to search payment types: %w", err)
+		}
+		if err = checkResponse(res.HTTPResponse, res.Body); err != nil {
+			return nil, PageInfo{}, fmt.Errorf("tripletex: refdata: failed to search payment types: %w", err)
+		}
+		if res.JSONDefault == nil {
+			return nil, PageInfo{}, nil
+		}
+		return listValues(res.JSONDefault.Values), res.JSONDefault.PageInfo(), nil
+	})
+}
+
+// PaymentTypeByName returns the payment type for incoming invoice payments
+// with description name, eg. "Betalt til bank". The name is matched
+// case-insensitively.
+func (r *RefData) PaymentTypeByName(ctx context.Context, name string) (*PaymentType, error) {
+	paymentTypes, err := r.PaymentTypes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if p, ok := findRef(paymentTypes, func(p PaymentType) bool {
+		return p.Description != nil && strings.EqualFold(*p.Description, name)
+	}); ok {
+		return p, nil
+	}
+	return nil, fmt.Errorf("%w: payment type %q", ErrNotFound, name)
+}
@@ -0,0 +1,89 @@
+package tripletex
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRefDataLookups(t *testing.T) {
+	require := require.New(t)
+
+	requests := make(map[string]int)
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /ledger/vatType", func(w http.ResponseWriter, r *http.Request) {
+		requests["vatType"]++
+		writeTestJSON(w, http.StatusOK, ListResponseVatType{Values: &[]VatType{
+			{Id: ptr(int64(1)), Number: ptr("3"), Percentage: ptr(float32(25))},
+		}})
+	})
+	mux.HandleFunc("GET /ledger/account", func(w http.ResponseWriter, r *http.Request) {
+		requests["account"]++
+		writeTestJSON(w, http.StatusOK, ListResponseAccount{Values: &[]Account{
+			{Id: ptr(int64(2)), Number: ptr(int32(3000))},
+		}})
+	})
+	mux.HandleFunc("GET /currency", func(w http.ResponseWriter, r *http.Request) {
+		requests["currency"]++
+		writeTestJSON(w, http.StatusOK, ListResponseCurrency{Values: &[]Currency{
+			{Id: ptr(int64(3)), Code: ptr("NOK")},
+		}})
+	})
+	mux.HandleFunc("GET /invoice/paymentType", func(w http.ResponseWriter, r *http.Request) {
+		requests["paymentType"]++
+		writeTestJSON(w, http.StatusOK, ListResponsePaymentType{Values: &[]PaymentType{
+			{Id: ptr(int64(4)), Description: ptr("Betalt til bank")},
+		}})
+	})
+	c := newTestClient(t, mux)
+	ctx := context.Background()
+
+	for range 2 {
+		vatType, err := c.RefData().VatTypeByNumber(ctx, "3")
+		require.NoError(err)
+		require.Equal(int64(1), *vatType.Id)
+
+		account, err := c.RefData().AccountByNumber(ctx, 3000)
+		require.NoError(err)
+		require.Equal(int64(2), *account.Id)
+
+		currency, err := c.RefData().CurrencyByCode(ctx, "nok")
+		require.NoError(err)
+		require.Equal(int64(3), *currency.Id)
+
+		paymentType, err := c.RefData().PaymentTypeByName(ctx, "betalt til bank")
+		require.NoError(err)
+		require.Equal(int64(4), *paymentType.Id)
+	}
+	require.Equal(map[string]int{"vatType": 1, "account": 1, "currency": 1, "paymentType": 1}, requests)
+
+	_, err := c.RefData().AccountByNumber(ctx, 9999)
+	require.True(errors.Is(err, ErrNotFound))
+
+	c.RefData().Invalidate()
+	_, err = c.RefData().VatTypeByNumber(ctx, "3")
+	require.NoError(err)
+	require.Equal(2, requests["vatType"])
+}
+
+func TestRefDataTTL(t *testing.T) {
+	require := require.New(t)
+
+	var requests int
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /currency", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		writeTestJSON(w, http.StatusOK, ListResponseCurrency{Values: &[]Currency{}})
+	})
+	c := newTestClient(t, mux, WithRefDataTTL(time.Nanosecond))
+
+	for range 2 {
+		_, err := c.RefData().Currencies(context.Background())
+		require.NoError(err)
+	}
+	require.Equal(2, requests)
+}
@@ -0,0 +1,267 @@
+package tripletex
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ErrAmbiguous is returned when a name matches more than one resource.
+var ErrAmbiguous = errors.New("tripletex: ambiguous")
+
+// maxSuggestions is the number of similar names listed when a name isn't
+// found.
+const maxSuggestions = 5
+
+// Resolver maps the names or numbers of activities, projects, departments and
+// employees to their ids, eg. for integrations that only know names. It's
+// used by the services taking names, eg. [TimesheetService.WriteWeekFor].
+//
+// Each list is loaded on first use and reloaded when older than the TTL set
+// by [WithRefDataTTL]. It's safe for concurrent use.
+//
+// Use [TripletexClient.Resolver] to get one.
+type Resolver struct {
+	client *TripletexClient
+	ttl    time.Duration
+
+	activities  refCache[namedRef]
+	projects    refCache[namedRef]
+	departments refCache[namedRef]
+	employees   refCache[namedRef]
+}
+
+// Resolver returns the [Resolver] of c.
+func (c *TripletexClient) Resolver() *Resolver {
+	return c.resolver
+}
+
+// namedRef is a resource that can be resolved by number or names.
+type namedRef struct {
+	id     int64
+	number string
+	names  []string
+}
+
+// Invalidate drops all cached lists, so they're reloaded on next use.
+func (r *Resolver) Invalidate() {
+	r.activities.invalidate()
+	r.projects.invalidate()
+	r.departments.invalidate()
+	r.employees.invalidate()
+}
+
+// ActivityId returns the id of the active activity with number or name.
+//
+// Names are matched case-insensitively, ignoring repeated whitespace. Returns
+// [ErrAmbiguous] if more than one activity matches, or [ErrNotFound] listing
+// similar names if none does.
+func (r *Resolver) ActivityId(ctx context.Context, nameOrNumber string) (int64, error) {
+	refs, err := r.activities.get(ctx, r.ttl, func(ctx context.Context, from, count int) ([]namedRef, PageInfo, error) {
+		f, inactive := "id,number,name", false
+		res, err := r.client.ActivitySearchWithResponse(ctx, &ActivitySearchParams{
+			IsInactive: &inactive,
+			Fields:     &f,
+			From:       &from,
+			Count:      &count,
+		})
+		if err != nil {
+			return nil, PageInfo{}, fmt.Errorf("tripletex: resolver: failed to search activities: %w", err)
+		}
+		if err = checkResponse(res.HTTPResponse, res.Body); err != nil {
+			return nil, PageInfo{}, fmt.Errorf("tripletex: resolver: failed to search activities: %w", err)
+		}
+		if res.JSONDefault == nil {
+			return nil, PageInfo{}, nil
+		}
+		return mapRefs(listValues(res.JSONDefault.Values), func(a Activity) namedRef {
+			return newNamedRef(a.Id, a.Number, a.Name)
+		}), res.JSONDefault.PageInfo(), nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return resolveRef("activity", nameOrNumber, refs)
+}
+
+// ProjectId returns the id of the open project with number or name. See
+// [Resolver.ActivityId].
+func (r *Resolver) ProjectId(ctx context.Context, nameOrNumber string) (int64, error) {
+	refs, err := r.projects.get(ctx, r.ttl, func(ctx context.Context, from, count int) ([]namedRef, PageInfo, error) {
+		f, closed := "id,number,name", false
+		res, err := r.client.ProjectSearchWithResponse(ctx, &ProjectSearchParams{
+			IsClosed: &closed,
+			Fields:   &f,
+			From:     &from,
+			Count:    &count,
+		})
+		if err != nil {
+			return nil, PageInfo{}, fmt.Errorf("tripletex: resolver: failed to search projects: %w", err)
+		}
+		if err = checkResponse(res.HTTPResponse, res.Body); err != nil {
+			return nil, PageInfo{}, fmt.Errorf("tripletex: resolver: failed to search projects: %w", err)
+		}
+		if res.JSONDefault == nil {
+			return nil, PageInfo{}, nil
+		}
+		return mapRefs(listValues(res.JSONDefault.Values), func(p Project) namedRef {
+			return newNamedRef(p.Id, p.Number, p.Name)
+		}), res.JSONDefault.PageInfo(), nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return resolveRef("project", nameOrNumber, refs)
+}
+
+// DepartmentId returns the id of the active department with number or name.
+// See [Resolver.ActivityId].
+func (r *Resolver) DepartmentId(ctx context.Context, nameOrNumber string) (int64, error) {
+	refs, err := r.departments.get(ctx, r.ttl, func(ctx context.Context, from, count int) ([]namedRef, PageInfo, error) {
+		f, inactive := "id,departmentNumber,name", false
+		res, err := r.client.DepartmentSearchWithResponse(ctx, &DepartmentSearchParams{
+			IsInactive: &inactive,
+			Fields:     &f,
+			From:       &from,
+			Count:      &count,
+		})
+		if err != nil {
+			return nil, PageInfo{}, fmt.Errorf("tripletex: resolver: failed to search departments: %w", err)
+		}
+		if err = checkResponse(res.HTTPResponse, res.Body); err != nil {
+			return nil, PageInfo{}, fmt.Errorf("tripletex: resolver: failed to search departments: %w", err)
+		}
+		if res.JSONDefault == nil {
+			return nil, PageInfo{}, nil
+		}
+		return mapRefs(listValues(res.JSONDefault.Values), func(d Department) namedRef {
+			return newNamedRef(d.Id, d.DepartmentNumber, d.Name)
+		}), res.JSONDefault.PageInfo(), nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return resolveRef("department", nameOrNumber, refs)
+}
+
+// EmployeeId returns the id of the employee with employee number, full name,
+// eg. "Ola Nordmann", or email. See [Resolver.ActivityId].
+func (r *Resolver) EmployeeId(ctx context.Context, nameOrNumber string) (int64, error) {
+	refs, err := r.employees.get(ctx, r.ttl, func(ctx context.Context, from, count int) ([]namedRef, PageInfo, error) {
+		f := "id,employeeNumber,firstName,lastName,email"
+		res, err := r.client.EmployeeSearchWithResponse(ctx, &EmployeeSearchParams{
+			Fields: &f,
+			From:   &from,
+			Count:  &count,
+		})
+		if err != nil {
+			return nil, PageInfo{}, fmt.Errorf("tripletex: resolver: failed to search employees: %w", err)
+		}
+		if err = checkResponse(res.HTTPResponse, res.Body); err != nil {
+			return nil, PageInfo{}, fmt.Errorf("tripletex: resolver: failed to search employees: %w", err)
+		}
+		if res.JSONDefault == nil {
+			return nil, PageInfo{}, nil
+		}
+		return mapRefs(listValues(res.JSONDefault.Values), func(e Employee) namedRef {
+			var fullName string
+			if e.FirstName != nil && e.LastName != nil {
+				fullName = *e.FirstName + " " + *e.LastName
+			}
+			return newNamedRef(e.Id, e.EmployeeNumber, &fullName, e.Email)
+		}), res.JSONDefault.PageInfo(), nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return resolveRef("employee", nameOrNumber, refs)
+}
+
+func mapRefs[T any](values []T, fn func(T) namedRef) []namedRef {
+	refs := make([]namedRef, 0, len(values))
+	for _, v := range values {
+		if ref := fn(v); ref.id != 0 {
+			refs = append(refs, ref)
+		}
+	}
+	return refs
+}
+
+func newNamedRef(id *int64, number *string, names ...*string) namedRef {
+	var ref namedRef
+	if id != nil {
+		ref.id = *id
+	}
+	if number != nil {
+		ref.number = strings.TrimSpace(*number)
+	}
+	for _, name := range names {
+		if name != nil && normalizeName(*name) != "" {
+			ref.names = append(ref.names, *name)
+		}
+	}
+	return ref
+}
+
+// resolveRef returns the id of the single ref in refs with number or a name
+// matching query.
+func resolveRef(kind, query string, refs []namedRef) (int64, error) {
+	q := normalizeName(query)
+	var matches []namedRef
+	for _, ref := range refs {
+		if ref.number != "" && strings.EqualFold(ref.number, strings.TrimSpace(query)) {
+			matches = append(matches, ref)
+			continue
+		}
+		for _, name := range ref.names {
+			if normalizeName(name) == q {
+				matches = append(matches, ref)
+				break
+			}
+		}
+	}
+
+	switch len(matches) {
+	case 1:
+		return matches[0].id, nil
+	case 0:
+		var similar []namedRef
+		for _, ref := range refs {
+			for _, name := range ref.names {
+				if q != "" && strings.Contains(normalizeName(name), q) {
+					similar = append(similar, ref)
+					break
+				}
+			}
+			if len(similar) == maxSuggestions {
+				break
+			}
+		}
+		if len(similar) > 0 {
+			return 0, fmt.Errorf("%w: %s %q, did you mean %s", ErrNotFound, kind, query, describeRefs(similar))
+		}
+		return 0, fmt.Errorf("%w: %s %q", ErrNotFound, kind, query)
+	default:
+		return 0, fmt.Errorf("%w: %s %q matches %s", ErrAmbiguous, kind, query, describeRefs(matches))
+	}
+}
+
+// describeRefs lists refs by name and id, eg. `"Design" (id 1), "Dev" (id 2)`.
+func describeRefs(refs []namedRef) string {
+	descriptions := make([]string, len(refs))
+	for i, ref := range refs {
+		name := ref.number
+		if len(ref.names) > 0 {
+			name = ref.names[0]
+		}
+		descriptions[i] = fmt.Sprintf("%q (id %d)", name, ref.id)
+	}
+	return strings.Join(descriptions, ", ")
+}
+
+// normalizeName lowercases name and collapses whitespace.
+func normalizeName(name string) string {
+	return strings.ToLower(strings.Join(strings.Fields(name), " "))
+}
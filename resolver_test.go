@@ -0,0 +1,64 @@
+package tripletex
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolver(t *testing.T) {
+	calls := map[string]int{}
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /activity", func(w http.ResponseWriter, r *http.Request) {
+		calls["activity"]++
+		writeTestJSON(w, http.StatusOK, ListResponseActivity{Values: &[]Activity{
+			{Id: ptr(int64(1)), Number: ptr("100"), Name: ptr("Design")},
+			{Id: ptr(int64(2)), Number: ptr("200"), Name: ptr("Development")},
+			{Id: ptr(int64(3)), Number: ptr("300"), Name: ptr("Meeting")},
+			{Id: ptr(int64(4)), Number: ptr("301"), Name: ptr("meeting")},
+		}})
+	})
+	mux.HandleFunc("GET /employee", func(w http.ResponseWriter, r *http.Request) {
+		calls["employee"]++
+		writeTestJSON(w, http.StatusOK, ListResponseEmployee{Values: &[]Employee{
+			{Id: ptr(int64(7)), EmployeeNumber: ptr("E7"), FirstName: ptr("Ola"), LastName: ptr("Nordmann"), Email: ptr("ola@example.com")},
+		}})
+	})
+	c := newTestClient(t, mux)
+	ctx := context.Background()
+
+	tests := []struct {
+		description string
+		resolve     func(ctx context.Context, nameOrNumber string) (int64, error)
+		query       string
+		expectedId  int64
+		expectedErr error
+		errContains string
+	}{
+		{description: "activity by name", resolve: c.Resolver().ActivityId, query: "design", expectedId: 1},
+		{description: "activity by number", resolve: c.Resolver().ActivityId, query: "200", expectedId: 2},
+		{description: "activity with extra whitespace", resolve: c.Resolver().ActivityId, query: "  Design ", expectedId: 1},
+		{description: "ambiguous activity", resolve: c.Resolver().ActivityId, query: "Meeting", expectedErr: ErrAmbiguous, errContains: `"Meeting" (id 3), "meeting" (id 4)`},
+		{description: "missing activity with suggestion", resolve: c.Resolver().ActivityId, query: "Dev", expectedErr: ErrNotFound, errContains: `did you mean "Development" (id 2)`},
+		{description: "missing activity", resolve: c.Resolver().ActivityId, query: "Travel", expectedErr: ErrNotFound},
+		{description: "employee by full name", resolve: c.Resolver().EmployeeId, query: "ola nordmann", expectedId: 7},
+		{description: "employee by email", resolve: c.Resolver().EmployeeId, query: "ola@example.com", expectedId: 7},
+		{description: "employee by number", resolve: c.Resolver().EmployeeId, query: "E7", expectedId: 7},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			require := require.New(t)
+			id, err := tt.resolve(ctx, tt.query)
+			if tt.expectedErr != nil {
+				require.ErrorIs(err, tt.expectedErr)
+				require.ErrorContains(err, tt.errContains)
+				return
+			}
+			require.NoError(err)
+			require.Equal(tt.expectedId, id)
+		})
+	}
+	require.Equal(t, map[string]int{"activity": 1, "employee": 1}, calls, "should cache lists")
+}
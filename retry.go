@@ -0,0 +1,116 @@
+package tripletex
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// WithThrottleRetries retries requests throttled with 429 Too Many Requests
+// up to maxRetries times. Defaults to 0, returning throttled responses as is.
+//
+// Each retry waits as long as the Retry-After header of the response says,
+// or the X-Rate-Limit-Reset header if missing, or else backs off
+// exponentially from one second. The throttled response is returned if the
+// wait would pass the deadline of the request context. Waits are logged at
+// warn level with the logger set by [WithLogger].
+func WithThrottleRetries(maxRetries int) Option {
+	return func(tc *TripletexClient) {
+		tc.throttleRetries = maxRetries
+	}
+}
+
+// retryTransport retries requests to next throttled with 429.
+type retryTransport struct {
+	next       http.RoundTripper
+	maxRetries int
+	logger     *slog.Logger
+	now        func() time.Time
+	sleep      func(ctx context.Context, d time.Duration) error
+}
+
+// withRetryTransport returns a copy of client retrying throttled requests.
+func withRetryTransport(client *http.Client, maxRetries int, logger *slog.Logger, now func() time.Time) *http.Client {
+	next := client.Transport
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	c := *client
+	c.Transport = &retryTransport{next: next, maxRetries: maxRetries, logger: logger, now: now, sleep: sleepContext}
+	return &c
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		res, err := t.next.RoundTrip(req)
+		if err != nil || res.StatusCode != http.StatusTooManyRequests || attempt >= t.maxRetries {
+			return res, err
+		}
+		if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+			return res, nil // Can't resend the body
+		}
+
+		wait := retryAfter(res.Header, t.now())
+		if wait < 0 {
+			wait = time.Second << attempt
+		}
+		if deadline, ok := req.Context().Deadline(); ok && t.now().Add(wait).After(deadline) {
+			return res, nil
+		}
+		if t.logger != nil {
+			t.logger.LogAttrs(req.Context(), slog.LevelWarn, "tripletex: throttled, retrying",
+				slog.String("method", req.Method),
+				slog.String("path", req.URL.Path),
+				slog.String("correlationId", req.Header.Get(CorrelationIdHeader)),
+				slog.Int("attempt", attempt+1),
+				slog.Duration("wait", wait))
+		}
+		res.Body.Close()
+
+		if err = t.sleep(req.Context(), wait); err != nil {
+			return nil, err
+		}
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req = req.Clone(req.Context())
+			req.Body = body
+		}
+	}
+}
+
+// retryAfter returns the wait given by the Retry-After header, in seconds or
+// as an HTTP date, or the X-Rate-Limit-Reset header, in seconds. Returns -1
+// if neither is set or valid.
+func retryAfter(h http.Header, now time.Time) time.Duration {
+	if v := h.Get("Retry-After"); v != "" {
+		if seconds, err := strconv.Atoi(v); err == nil && seconds >= 0 {
+			return time.Duration(seconds) * time.Second
+		}
+		if date, err := http.ParseTime(v); err == nil {
+			return max(date.Sub(now), 0)
+		}
+	}
+	if v := h.Get("X-Rate-Limit-Reset"); v != "" {
+		if seconds, err := strconv.Atoi(v); err == nil && seconds >= 0 {
+			return time.Duration(seconds) * time.Second
+		}
+	}
+	return -1
+}
+
+// sleepContext waits for d, or until ctx is done.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,141 @@
+package tripletex
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetryAfter(t *testing.T) {
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		description string
+		header      http.Header
+		expected    time.Duration
+	}{
+		{description: "seconds", header: http.Header{"Retry-After": {"3"}}, expected: 3 * time.Second},
+		{description: "date", header: http.Header{"Retry-After": {now.Add(5 * time.Second).Format(http.TimeFormat)}}, expected: 5 * time.Second},
+		{description: "past date", header: http.Header{"Retry-After": {now.Add(-time.Minute).Format(http.TimeFormat)}}, expected: 0},
+		{description: "rate limit reset", header: http.Header{"X-Rate-Limit-Reset": {"7"}}, expected: 7 * time.Second},
+		{description: "invalid", header: http.Header{"Retry-After": {"soon"}}, expected: -1},
+		{description: "missing", header: http.Header{}, expected: -1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			require.Equal(t, tt.expected, retryAfter(tt.header, now))
+		})
+	}
+}
+
+func TestRetryTransport(t *testing.T) {
+	tests := []struct {
+		description    string
+		retryAfter     string
+		maxRetries     int
+		deadline       time.Duration
+		expectedStatus int
+		expectedWaits  []time.Duration
+	}{
+		{
+			description:    "waits retry-after",
+			retryAfter:     "2",
+			maxRetries:     3,
+			expectedStatus: http.StatusOK,
+			expectedWaits:  []time.Duration{2 * time.Second, 2 * time.Second},
+		},
+		{
+			description:    "backs off without retry-after",
+			maxRetries:     3,
+			expectedStatus: http.StatusOK,
+			expectedWaits:  []time.Duration{time.Second, 2 * time.Second},
+		},
+		{
+			description:    "gives up after max retries",
+			retryAfter:     "2",
+			maxRetries:     1,
+			expectedStatus: http.StatusTooManyRequests,
+			expectedWaits:  []time.Duration{2 * time.Second},
+		},
+		{
+			description:    "gives up when passing deadline",
+			retryAfter:     "60",
+			maxRetries:     3,
+			deadline:       time.Second,
+			expectedStatus: http.StatusTooManyRequests,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			require := require.New(t)
+
+			calls := 0
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				calls++
+				body, _ := io.ReadAll(r.Body)
+				require.Equal(`{"name":"Acme"}`, string(body), "should resend the body")
+				if calls <= 2 {
+					if tt.retryAfter != "" {
+						w.Header().Set("Retry-After", tt.retryAfter)
+					}
+					w.WriteHeader(http.StatusTooManyRequests)
+					return
+				}
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer srv.Close()
+
+			var waits []time.Duration
+			transport := &retryTransport{
+				next:       http.DefaultTransport,
+				maxRetries: tt.maxRetries,
+				now:        time.Now,
+				sleep: func(ctx context.Context, d time.Duration) error {
+					waits = append(waits, d)
+					return nil
+				},
+			}
+
+			ctx := context.Background()
+			if tt.deadline != 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, tt.deadline)
+				defer cancel()
+			}
+			req, err := http.NewRequestWithContext(ctx, http.MethodPost, srv.URL, strings.NewReader(`{"name":"Acme"}`))
+			require.NoError(err)
+			res, err := (&http.Client{Transport: transport}).Do(req)
+			require.NoError(err)
+			res.Body.Close()
+			require.Equal(tt.expectedStatus, res.StatusCode)
+			require.Equal(tt.expectedWaits, waits)
+		})
+	}
+}
+
+func TestWithThrottleRetries(t *testing.T) {
+	require := require.New(t)
+
+	calls := 0
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /customer/{id}", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.Header().Set("Retry-After", "0")
+			writeTestJSON(w, http.StatusTooManyRequests, APIError{Status: http.StatusTooManyRequests})
+			return
+		}
+		writeTestJSON(w, http.StatusOK, ResponseWrapperCustomer{Value: &Customer{Id: ptr(int64(1))}})
+	})
+	c := newTestClient(t, mux, WithThrottleRetries(1))
+
+	res, err := c.CustomerGetWithResponse(context.Background(), 1, &CustomerGetParams{})
+	require.NoError(err)
+	require.NoError(checkResponse(res.HTTPResponse, res.Body))
+	require.Equal(2, calls)
+}
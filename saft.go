@@ -0,0 +1,79 @@
+package tripletex
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// SAFTStage is a stage of [TripletexClient.ExportSAFT].
+type SAFTStage int
+
+const (
+	// SAFTGenerating is reported when the export is requested. Tripletex
+	// generates the file before responding, which can take minutes.
+	SAFTGenerating SAFTStage = iota
+	// SAFTDownloading is reported as the file is written, with the bytes
+	// written so far.
+	SAFTDownloading
+	// SAFTDone is reported once the whole file is written.
+	SAFTDone
+)
+
+// SAFTProgress is the progress of [TripletexClient.ExportSAFT].
+type SAFTProgress struct {
+	Stage SAFTStage
+	Bytes int64 // Bytes written to the writer so far
+	Total int64 // Size of the file, or -1 if unknown
+}
+
+// ExportSAFT exports the ledger of year as a SAF-T Financial XML file and
+// streams it to w, calling onProgress, if not nil, as it goes.
+//
+// Tripletex generates the file while the request is open, so there is no job
+// to poll: the call blocks until the file is generated and downloaded, or
+// ctx is done. Returns the number of bytes written.
+func (c *TripletexClient) ExportSAFT(ctx context.Context, year int, w io.Writer, onProgress func(SAFTProgress)) (int64, error) {
+	if onProgress == nil {
+		onProgress = func(SAFTProgress) {}
+	}
+
+	onProgress(SAFTProgress{Stage: SAFTGenerating, Total: -1})
+	res, err := c.SaftExportSAFTExportSAFT(ctx, &SaftExportSAFTExportSAFTParams{Year: int32(year)})
+	if err != nil {
+		return 0, fmt.Errorf("tripletex: saft: failed to export %d: %w", year, err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(res.Body)
+		if err = checkResponse(res, body); err == nil {
+			err = fmt.Errorf("tripletex: saft: unexpected status %s", res.Status)
+		}
+		return 0, fmt.Errorf("tripletex: saft: failed to export %d: %w", year, err)
+	}
+
+	pw := &progressWriter{w: w, total: res.ContentLength, onProgress: onProgress}
+	n, err := io.Copy(pw, res.Body)
+	if err != nil {
+		return n, fmt.Errorf("tripletex: saft: failed to download %d: %w", year, err)
+	}
+	onProgress(SAFTProgress{Stage: SAFTDone, Bytes: n, Total: n})
+	return n, nil
+}
+
+// progressWriter reports the bytes written to w.
+type progressWriter struct {
+	w          io.Writer
+	written    int64
+	total      int64
+	onProgress func(SAFTProgress)
+}
+
+func (pw *progressWriter) Write(p []byte) (int, error) {
+	n, err := pw.w.Write(p)
+	pw.written += int64(n)
+	pw.onProgress(SAFTProgress{Stage: SAFTDownloading, Bytes: pw.written, Total: pw.total})
+	return n, err
+}
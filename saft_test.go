@@ -0,0 +1,44 @@
+package tripletex
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportSAFT(t *testing.T) {
+	require := require.New(t)
+
+	file := `<?xml version="1.0"?><AuditFile>` + strings.Repeat("<Line/>", 10000) + `</AuditFile>`
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /saft/exportSAFT", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("year") != "2024" {
+			writeTestJSON(w, http.StatusUnprocessableEntity, APIError{Status: 422, Message: "No ledger for year"})
+			return
+		}
+		w.Header().Set("Content-Type", "application/xml")
+		_, _ = w.Write([]byte(file))
+	})
+	c := newTestClient(t, mux)
+
+	var buf bytes.Buffer
+	var progress []SAFTProgress
+	n, err := c.ExportSAFT(context.Background(), 2024, &buf, func(p SAFTProgress) {
+		progress = append(progress, p)
+	})
+	require.NoError(err)
+	require.Equal(int64(len(file)), n)
+	require.Equal(file, buf.String())
+	require.Equal(SAFTGenerating, progress[0].Stage)
+	require.Equal(SAFTDownloading, progress[1].Stage)
+	require.Equal(SAFTProgress{Stage: SAFTDone, Bytes: n, Total: n}, progress[len(progress)-1])
+
+	_, err = c.ExportSAFT(context.Background(), 2019, &buf, nil)
+	var apiErr *APIError
+	require.ErrorAs(err, &apiErr)
+	require.Equal(422, apiErr.Status)
+}
@@ -0,0 +1,181 @@
+package tripletex
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// SalaryService groups the payslip, salary transaction, salary type and
+// salary settings endpoints.
+//
+// Use [TripletexClient.Salary] to get one.
+type SalaryService struct {
+	client *TripletexClient
+}
+
+// Salary returns a [SalaryService] using c.
+func (c *TripletexClient) Salary() *SalaryService {
+	return &SalaryService{client: c}
+}
+
+// SalaryLine is a line on an employee's payslip.
+type SalaryLine struct {
+	EmployeeId int64
+	// SalaryTypeNumber is the number of the salary type, eg. "2000" for fixed
+	// salary. It's resolved to a salary type by [SalaryService.CreateTransaction].
+	SalaryTypeNumber string
+	Rate             float32
+	Count            float32
+	Description      string // Optional
+}
+
+// SalaryRun is the input to [SalaryService.CreateTransaction].
+type SalaryRun struct {
+	Date                 time.Time // Voucher date
+	Year                 int32     // Defaults to the year of Date
+	Month                int32     // Defaults to the month of Date
+	Lines                []SalaryLine
+	GenerateTaxDeduction bool
+}
+
+// Payslips returns all payslips matching params. From and Count in params are
+// ignored.
+func (s *SalaryService) Payslips(ctx context.Context, params SalaryPayslipSearchParams) ([]Payslip, error) {
+	return collectPages(ctx, 0, func(ctx context.Context, from, count int) ([]Payslip, PageInfo, error) {
+		params.From = &from
+		params.Count = &count
+		res, err := s.client.SalaryPayslipSearchWithResponse(ctx, &params)
+		if err != nil {
+			return nil, PageInfo{}, fmt.Errorf("tripletex: salary: failed to search payslips: %w", err)
+		}
+		if err = checkResponse(res.HTTPResponse, res.Body); err != nil {
+			return nil, PageInfo{}, fmt.Errorf("tripletex: salary: failed to search payslips: %w", err)
+		}
+		if res.JSONDefault == nil {
+			return nil, PageInfo{}, nil
+		}
+		return listValues(res.JSONDefault.Values), res.JSONDefault.PageInfo(), nil
+	})
+}
+
+// SalaryType returns the active salary type with number.
+//
+// Returns [ErrNotFound] if no salary type has number.
+func (s *SalaryService) SalaryType(ctx context.Context, number string) (*SalaryType, error) {
+	inactive := false
+	res, err := s.client.SalaryTypeSearchWithResponse(ctx, &SalaryTypeSearchParams{
+		Number:     &number,
+		IsInactive: &inactive,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("tripletex: salary: failed to search salary type %q: %w", number, err)
+	}
+	if err = checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return nil, fmt.Errorf("tripletex: salary: failed to search salary type %q: %w", number, err)
+	}
+	if res.JSONDefault != nil {
+		// The number filter is not guaranteed to be an exact match.
+		for _, t := range listValues(res.JSONDefault.Values) {
+			if t.Number != nil && *t.Number == number {
+				return &t, nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("%w: salary type %q", ErrNotFound, number)
+}
+
+// CreateTransaction creates a salary transaction with a payslip per employee
+// in run.
+//
+// Salary types are looked up by number before anything is created, so an
+// unknown salary type number fails without side effects.
+func (s *SalaryService) CreateTransaction(ctx context.Context, run SalaryRun) (*SalaryTransaction, error) {
+	if run.Date.IsZero() {
+		return nil, fmt.Errorf("tripletex: salary: salary run requires date")
+	}
+	if len(run.Lines) == 0 {
+		return nil, fmt.Errorf("tripletex: salary: salary run requires at least one line")
+	}
+	if run.Year == 0 {
+		run.Year = int32(run.Date.Year())
+	}
+	if run.Month == 0 {
+		run.Month = int32(run.Date.Month())
+	}
+
+	salaryTypes := make(map[string]*SalaryType)
+	for _, line := range run.Lines {
+		if _, ok := salaryTypes[line.SalaryTypeNumber]; ok {
+			continue
+		}
+		t, err := s.SalaryType(ctx, line.SalaryTypeNumber)
+		if err != nil {
+			return nil, err
+		}
+		salaryTypes[line.SalaryTypeNumber] = t
+	}
+
+	date := run.Date.Format(time.DateOnly)
+	var payslips []Payslip
+	payslipIndex := make(map[int64]int)
+	for _, line := range run.Lines {
+		i, ok := payslipIndex[line.EmployeeId]
+		if !ok {
+			i = len(payslips)
+			payslipIndex[line.EmployeeId] = i
+			payslips = append(payslips, Payslip{
+				Employee:       &Employee{Id: &line.EmployeeId},
+				Date:           &date,
+				Year:           &run.Year,
+				Month:          &run.Month,
+				Specifications: &[]SalarySpecification{},
+			})
+		}
+
+		spec := SalarySpecification{
+			Employee:   &Employee{Id: &line.EmployeeId},
+			SalaryType: &SalaryType{Id: salaryTypes[line.SalaryTypeNumber].Id},
+			Rate:       &line.Rate,
+			Count:      &line.Count,
+		}
+		if line.Description != "" {
+			spec.Description = &line.Description
+		}
+		*payslips[i].Specifications = append(*payslips[i].Specifications, spec)
+	}
+
+	res, err := s.client.SalaryTransactionPostWithApplicationJSONCharsetUTF8BodyWithResponse(ctx, &SalaryTransactionPostParams{
+		GenerateTaxDeduction: &run.GenerateTaxDeduction,
+	}, SalaryTransaction{
+		Date:     &date,
+		Year:     &run.Year,
+		Month:    &run.Month,
+		Payslips: &payslips,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("tripletex: salary: failed to create transaction: %w", err)
+	}
+	if err = checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return nil, fmt.Errorf("tripletex: salary: failed to create transaction: %w", err)
+	}
+	if res.JSONDefault == nil || res.JSONDefault.Value == nil {
+		return nil, fmt.Errorf("tripletex: salary: created transaction is empty")
+	}
+	return res.JSONDefault.Value, nil
+}
+
+// Settings returns the salary settings of the logged in company.
+func (s *SalaryService) Settings(ctx context.Context) (*SalarySettings, error) {
+	res, err := s.client.SalarySettingsGetWithResponse(ctx, &SalarySettingsGetParams{})
+	if err != nil {
+		return nil, fmt.Errorf("tripletex: salary: failed to get settings: %w", err)
+	}
+	if err = checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return nil, fmt.Errorf("tripletex: salary: failed to get settings: %w", err)
+	}
+	if res.JSONDefault == nil || res.JSONDefault.Value == nil {
+		return nil, fmt.Errorf("tripletex: salary: settings is empty")
+	}
+	return res.JSONDefault.Value, nil
+}
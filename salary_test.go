@@ -0,0 +1,63 @@
+package tripletex
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSalaryCreateTransaction(t *testing.T) {
+	require := require.New(t)
+
+	var posted bool
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /salary/type", func(w http.ResponseWriter, r *http.Request) {
+		number := r.URL.Query().Get("number")
+		var types []SalaryType
+		if number == "2000" {
+			types = append(types, SalaryType{Id: ptr(int64(11)), Number: ptr("2000")})
+		}
+		writeTestJSON(w, http.StatusOK, ListResponseSalaryType{Values: &types})
+	})
+	mux.HandleFunc("POST /salary/transaction", func(w http.ResponseWriter, r *http.Request) {
+		posted = true
+		require.Equal("true", r.URL.Query().Get("generateTaxDeduction"))
+		var transaction SalaryTransaction
+		require.NoError(json.NewDecoder(r.Body).Decode(&transaction))
+		require.Equal(int32(2025), *transaction.Year)
+		require.Equal(int32(3), *transaction.Month)
+		require.Len(*transaction.Payslips, 2)
+		specs := *(*transaction.Payslips)[0].Specifications
+		require.Len(specs, 2)
+		require.Equal(int64(11), *specs[0].SalaryType.Id)
+		transaction.Id = ptr(int64(99))
+		writeTestJSON(w, http.StatusCreated, ResponseWrapperSalaryTransaction{Value: &transaction})
+	})
+	c := newTestClient(t, mux)
+
+	date := time.Date(2025, 3, 20, 0, 0, 0, 0, time.UTC)
+	transaction, err := c.Salary().CreateTransaction(context.Background(), SalaryRun{
+		Date: date,
+		Lines: []SalaryLine{
+			{EmployeeId: 1, SalaryTypeNumber: "2000", Rate: 50000, Count: 1},
+			{EmployeeId: 2, SalaryTypeNumber: "2000", Rate: 45000, Count: 1},
+			{EmployeeId: 1, SalaryTypeNumber: "2000", Rate: 5000, Count: 1, Description: "Bonus"},
+		},
+		GenerateTaxDeduction: true,
+	})
+	require.NoError(err)
+	require.Equal(int64(99), *transaction.Id)
+
+	posted = false
+	_, err = c.Salary().CreateTransaction(context.Background(), SalaryRun{
+		Date:  date,
+		Lines: []SalaryLine{{EmployeeId: 1, SalaryTypeNumber: "9999", Rate: 1, Count: 1}},
+	})
+	require.ErrorIs(err, ErrNotFound)
+	require.ErrorContains(err, `salary type "9999"`)
+	require.False(posted)
+}
@@ -0,0 +1,18 @@
+package tripletex
+
+import "net/http"
+
+// Spec is an OpenAPI specification of the API, used by [WithDryRun],
+// [WithSchemaDriftHandler] and [WithDefaultFields].
+//
+// The specification the client is generated from is bundled in the spec
+// package, eg. spec.Bundled(), which isn't linked unless imported.
+type Spec interface {
+	// Operation returns the operation id of req, sent to baseURL, eg.
+	// "Customer_search", and the names of its query parameters.
+	Operation(req *http.Request, baseURL string) (operationId string, queryParams []string, err error)
+	// ValidateRequest validates req, sent to baseURL.
+	ValidateRequest(req *http.Request, baseURL string) error
+	// ValidateResponse validates res, with body, to req sent to baseURL.
+	ValidateResponse(req *http.Request, res *http.Response, body []byte, baseURL string) error
+}
@@ -0,0 +1,155 @@
+// Package spec bundles the OpenAPI specification of the Tripletex API the
+// client is generated from, for the options of the tripletex package
+// validating requests and responses against it:
+//
+//	c := tripletex.New(creds, tripletex.WithDryRun(spec.Bundled()))
+//
+// It's a separate package so that binaries not using these options don't
+// link the specification and its validator.
+package spec
+
+import (
+	"bytes"
+	"context"
+	_ "embed"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/getkin/kin-openapi/routers/gorillamux"
+)
+
+// openapiJSON is the OpenAPI specification the client is generated from.
+//
+//go:embed openapi.json
+var openapiJSON []byte
+
+// serverURL is the server URL of the operations in [openapiJSON].
+const serverURL = "https://tripletex.no/v2"
+
+// Spec is an OpenAPI specification, parsed on first use. It's safe for
+// concurrent use.
+type Spec struct {
+	load func() (routers.Router, error)
+}
+
+// bundled is the [Spec] of [openapiJSON].
+var bundled = New(openapiJSON)
+
+// Bundled returns the [Spec] the client is generated from.
+func Bundled() *Spec {
+	return bundled
+}
+
+// New returns the [Spec] of the OpenAPI document data, eg. a newer version
+// of the specification than the bundled one.
+func New(data []byte) *Spec {
+	return &Spec{load: sync.OnceValues(func() (routers.Router, error) {
+		doc, err := openapi3.NewLoader().LoadFromData(data)
+		if err != nil {
+			return nil, fmt.Errorf("tripletex: spec: failed to load: %w", err)
+		}
+		doc.Servers = openapi3.Servers{{URL: serverURL}}
+
+		router, err := gorillamux.NewRouter(doc)
+		if err != nil {
+			return nil, fmt.Errorf("tripletex: spec: failed to build router: %w", err)
+		}
+		return router, nil
+	})}
+}
+
+// Operation returns the operation id of req, sent to baseURL, eg.
+// "Customer_search", and the names of its query parameters.
+func (s *Spec) Operation(req *http.Request, baseURL string) (string, []string, error) {
+	route, _, err := s.findRoute(req, baseURL)
+	if err != nil {
+		return "", nil, err
+	}
+	var params []string
+	for _, p := range route.Operation.Parameters {
+		if p.Value != nil && p.Value.In == openapi3.ParameterInQuery {
+			params = append(params, p.Value.Name)
+		}
+	}
+	return route.Operation.OperationID, params, nil
+}
+
+// ValidateRequest validates req, sent to baseURL. Reads the body of req
+// without closing it.
+func (s *Spec) ValidateRequest(req *http.Request, baseURL string) error {
+	route, pathParams, err := s.findRoute(req, baseURL)
+	if err != nil {
+		return err
+	}
+	return openapi3filter.ValidateRequest(req.Context(), &openapi3filter.RequestValidationInput{
+		Request:    req,
+		PathParams: pathParams,
+		Route:      route,
+		Options:    validationOptions,
+	})
+}
+
+// ValidateResponse validates res, with body, to req sent to baseURL.
+func (s *Spec) ValidateResponse(req *http.Request, res *http.Response, body []byte, baseURL string) error {
+	route, pathParams, err := s.findRoute(req, baseURL)
+	if err != nil {
+		return err
+	}
+	return openapi3filter.ValidateResponse(req.Context(), &openapi3filter.ResponseValidationInput{
+		RequestValidationInput: &openapi3filter.RequestValidationInput{
+			Request:    req,
+			PathParams: pathParams,
+			Route:      route,
+			Options:    validationOptions,
+		},
+		Status:  res.StatusCode,
+		Header:  res.Header,
+		Body:    io.NopCloser(bytes.NewReader(body)),
+		Options: validationOptions,
+	})
+}
+
+// validationOptions reports all mismatches, and skips authentication, which
+// isn't described by the specification.
+var validationOptions = &openapi3filter.Options{
+	MultiError:         true,
+	AuthenticationFunc: openapi3filter.NoopAuthenticationFunc,
+}
+
+// findRoute returns the operation of req, and its path parameters.
+//
+// req is sent to baseURL, which is mapped to [serverURL] so operations are
+// found for custom base URLs too.
+func (s *Spec) findRoute(req *http.Request, baseURL string) (*routers.Route, map[string]string, error) {
+	router, err := s.load()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	base, err := url.Parse(baseURL)
+	if err != nil {
+		return nil, nil, fmt.Errorf("tripletex: spec: failed to parse base URL: %w", err)
+	}
+	u, err := url.Parse(serverURL + strings.TrimPrefix(req.URL.Path, strings.TrimSuffix(base.Path, "/")))
+	if err != nil {
+		return nil, nil, fmt.Errorf("tripletex: spec: failed to map %s: %w", req.URL.Path, err)
+	}
+	u.RawQuery = req.URL.RawQuery
+
+	specReq := req.Clone(context.WithoutCancel(req.Context()))
+	specReq.URL = u
+	specReq.Host = u.Host
+
+	route, pathParams, err := router.FindRoute(specReq)
+	if err != nil {
+		return nil, nil, fmt.Errorf("tripletex: spec: no operation for %s %s: %w", req.Method, req.URL.Path, err)
+	}
+	return route, pathParams, nil
+}
@@ -0,0 +1,39 @@
+package spec
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestOperation(t *testing.T) {
+	tests := []struct {
+		description         string
+		method              string
+		url                 string
+		baseURL             string
+		expectedOperationId string
+		expectedErr         bool
+	}{
+		{description: "search", method: http.MethodGet, url: "https://tripletex.no/v2/customer", baseURL: "https://tripletex.no/v2", expectedOperationId: "Customer_search"},
+		{description: "custom base URL", method: http.MethodGet, url: "http://127.0.0.1:8080/api/customer/1", baseURL: "http://127.0.0.1:8080/api", expectedOperationId: "Customer_get"},
+		{description: "unknown path", method: http.MethodGet, url: "https://tripletex.no/v2/unknown", baseURL: "https://tripletex.no/v2", expectedErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			require := require.New(t)
+			req, err := http.NewRequest(tt.method, tt.url, nil)
+			require.NoError(err)
+
+			operationId, params, err := Bundled().Operation(req, tt.baseURL)
+			if tt.expectedErr {
+				require.Error(err)
+				return
+			}
+			require.NoError(err)
+			require.Equal(tt.expectedOperationId, operationId)
+			require.Contains(params, "fields")
+		})
+	}
+}
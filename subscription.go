@@ -0,0 +1,250 @@
+package tripletex
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// EventKey is the name of a Tripletex event, in the form "subject.verb".
+//
+// Use [SubscriptionService.EventKeys] to list all events available.
+type EventKey string
+
+const (
+	EventCustomerCreate EventKey = "customer.create"
+	EventCustomerUpdate EventKey = "customer.update"
+	EventCustomerDelete EventKey = "customer.delete"
+	EventSupplierCreate EventKey = "supplier.create"
+	EventSupplierUpdate EventKey = "supplier.update"
+	EventSupplierDelete EventKey = "supplier.delete"
+	EventContactCreate  EventKey = "contact.create"
+	EventContactUpdate  EventKey = "contact.update"
+	EventContactDelete  EventKey = "contact.delete"
+	EventProductCreate  EventKey = "product.create"
+	EventProductUpdate  EventKey = "product.update"
+	EventProductDelete  EventKey = "product.delete"
+	EventOrderCreate    EventKey = "order.create"
+	EventOrderUpdate    EventKey = "order.update"
+	EventOrderDelete    EventKey = "order.delete"
+	EventProjectCreate  EventKey = "project.create"
+	EventProjectUpdate  EventKey = "project.update"
+	EventProjectDelete  EventKey = "project.delete"
+	EventEmployeeCreate EventKey = "employee.create"
+	EventEmployeeUpdate EventKey = "employee.update"
+	EventEmployeeDelete EventKey = "employee.delete"
+	EventInvoiceCharged EventKey = "invoice.charged"
+)
+
+// SubscriptionService groups the event subscription (webhook) endpoints.
+//
+// Use [TripletexClient.Subscriptions] to get one.
+type SubscriptionService struct {
+	client *TripletexClient
+}
+
+// Subscriptions returns a [SubscriptionService] using c.
+func (c *TripletexClient) Subscriptions() *SubscriptionService {
+	return &SubscriptionService{client: c}
+}
+
+// SubscriptionSpec describes a subscription to create.
+type SubscriptionSpec struct {
+	Event     EventKey
+	TargetUrl string // Absolute HTTPS URL
+	Fields    string // Optional fields of the object delivered with the event
+
+	AuthHeaderName   string // Optional
+	AuthHeaderValue  string // Optional
+	HmacSharedSecret string // Optional, enables HMAC signing
+}
+
+func (s SubscriptionSpec) subscription() Subscription {
+	event := string(s.Event)
+	sub := Subscription{Event: &event, TargetUrl: &s.TargetUrl}
+	if s.Fields != "" {
+		sub.Fields = &s.Fields
+	}
+	if s.AuthHeaderName != "" {
+		sub.AuthHeaderName = &s.AuthHeaderName
+	}
+	if s.AuthHeaderValue != "" {
+		sub.AuthHeaderValue = &s.AuthHeaderValue
+	}
+	if s.HmacSharedSecret != "" {
+		sub.HmacSharedSecret = &s.HmacSharedSecret
+	}
+	return sub
+}
+
+// matches reports whether sub is a subscription of spec's event to spec's
+// target URL.
+func (s SubscriptionSpec) matches(sub Subscription) bool {
+	return sub.Event != nil && *sub.Event == string(s.Event) &&
+		sub.TargetUrl != nil && *sub.TargetUrl == s.TargetUrl
+}
+
+// EventKeys returns the events that can be subscribed to, sorted.
+func (s *SubscriptionService) EventKeys(ctx context.Context) ([]EventKey, error) {
+	res, err := s.client.EventGetWithResponse(ctx, &EventGetParams{})
+	if err != nil {
+		return nil, fmt.Errorf("tripletex: subscription: failed to get events: %w", err)
+	}
+	if err = checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return nil, fmt.Errorf("tripletex: subscription: failed to get events: %w", err)
+	}
+	if res.JSONDefault == nil || res.JSONDefault.Value == nil {
+		return nil, nil
+	}
+
+	keys := make([]EventKey, 0, len(*res.JSONDefault.Value))
+	for k := range *res.JSONDefault.Value {
+		keys = append(keys, EventKey(k))
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+	return keys, nil
+}
+
+// Create creates a subscription to spec.Event for the employee token of the
+// client.
+func (s *SubscriptionService) Create(ctx context.Context, spec SubscriptionSpec) (*Subscription, error) {
+	res, err := s.client.EventSubscriptionPostWithApplicationJSONCharsetUTF8BodyWithResponse(ctx, spec.subscription())
+	if err != nil {
+		return nil, fmt.Errorf("tripletex: subscription: failed to create %s: %w", spec.Event, err)
+	}
+	if err = checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return nil, fmt.Errorf("tripletex: subscription: failed to create %s: %w", spec.Event, err)
+	}
+	if res.JSONDefault == nil || res.JSONDefault.Value == nil {
+		return nil, fmt.Errorf("tripletex: subscription: created subscription is empty")
+	}
+	return res.JSONDefault.Value, nil
+}
+
+// List returns all subscriptions.
+func (s *SubscriptionService) List(ctx context.Context) ([]Subscription, error) {
+	return collectPages(ctx, 0, func(ctx context.Context, from, count int) ([]Subscription, PageInfo, error) {
+		res, err := s.client.EventSubscriptionSearchWithResponse(ctx, &EventSubscriptionSearchParams{
+			From:  &from,
+			Count: &count,
+		})
+		if err != nil {
+			return nil, PageInfo{}, fmt.Errorf("tripletex: subscription: failed to search: %w", err)
+		}
+		if err = checkResponse(res.HTTPResponse, res.Body); err != nil {
+			return nil, PageInfo{}, fmt.Errorf("tripletex: subscription: failed to search: %w", err)
+		}
+		if res.JSONDefault == nil {
+			return nil, PageInfo{}, nil
+		}
+		return listValues(res.JSONDefault.Values), res.JSONDefault.PageInfo(), nil
+	})
+}
+
+// Renew reactivates the subscription with id, eg. after Tripletex disabled it
+// because of too many failed deliveries.
+func (s *SubscriptionService) Renew(ctx context.Context, id int64) (*Subscription, error) {
+	current, err := s.client.EventSubscriptionGetWithResponse(ctx, id, &EventSubscriptionGetParams{})
+	if err != nil {
+		return nil, fmt.Errorf("tripletex: subscription: failed to get %d: %w", id, err)
+	}
+	if err = checkResponse(current.HTTPResponse, current.Body); err != nil {
+		return nil, fmt.Errorf("tripletex: subscription: failed to get %d: %w", id, err)
+	}
+	if current.JSONDefault == nil || current.JSONDefault.Value == nil {
+		return nil, fmt.Errorf("tripletex: subscription: subscription %d is empty", id)
+	}
+
+	active := ACTIVE
+	res, err := s.client.EventSubscriptionPutWithResponse(ctx, id, Subscription{
+		Id:        &id,
+		Version:   current.JSONDefault.Value.Version,
+		Event:     current.JSONDefault.Value.Event,
+		TargetUrl: current.JSONDefault.Value.TargetUrl,
+		Status:    &active,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("tripletex: subscription: failed to renew %d: %w", id, err)
+	}
+	if err = checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return nil, fmt.Errorf("tripletex: subscription: failed to renew %d: %w", id, err)
+	}
+	if res.JSONDefault == nil || res.JSONDefault.Value == nil {
+		return nil, fmt.Errorf("tripletex: subscription: renewed subscription is empty")
+	}
+	return res.JSONDefault.Value, nil
+}
+
+// Delete deletes the subscription with id.
+func (s *SubscriptionService) Delete(ctx context.Context, id int64) error {
+	res, err := s.client.EventSubscriptionDeleteWithResponse(ctx, id)
+	if err != nil {
+		return fmt.Errorf("tripletex: subscription: failed to delete %d: %w", id, err)
+	}
+	if err = checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return fmt.Errorf("tripletex: subscription: failed to delete %d: %w", id, err)
+	}
+	return nil
+}
+
+// Ensure makes sure there is an active subscription for each of specs.
+//
+// Subscriptions are matched on event and target URL. Missing subscriptions
+// are created, and inactive ones are deleted and created again, since their
+// write-only secrets can't be read back. Returns the active subscriptions in
+// the order of specs.
+func (s *SubscriptionService) Ensure(ctx context.Context, specs []SubscriptionSpec) ([]Subscription, error) {
+	existing, err := s.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ensured := make([]Subscription, 0, len(specs))
+	for _, spec := range specs {
+		var active *Subscription
+		for _, sub := range existing {
+			if !spec.matches(sub) {
+				continue
+			}
+			if sub.Status != nil && *sub.Status == ACTIVE && active == nil {
+				active = &sub
+				continue
+			}
+			if sub.Id == nil {
+				return ensured, fmt.Errorf("tripletex: subscription: %s subscription to %s has no id", spec.Event, spec.TargetUrl)
+			}
+			if err = s.Delete(ctx, *sub.Id); err != nil {
+				return ensured, err
+			}
+		}
+
+		if active == nil {
+			if active, err = s.Create(ctx, spec); err != nil {
+				return ensured, err
+			}
+		}
+		ensured = append(ensured, *active)
+	}
+	return ensured, nil
+}
+
+// KeepActive calls [SubscriptionService.Ensure] with specs every interval,
+// until ctx is done. Errors are passed to onError, if not nil, and don't stop
+// the loop.
+//
+// Returns ctx.Err() when ctx is done.
+func (s *SubscriptionService) KeepActive(ctx context.Context, interval time.Duration, specs []SubscriptionSpec, onError func(error)) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if _, err := s.Ensure(ctx, specs); err != nil && onError != nil {
+			onError(err)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package tripletex
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubscriptionEnsure(t *testing.T) {
+	require := require.New(t)
+
+	active := ACTIVE
+	disabled := DISABLEDTOOMANYERRORS
+	var deleted []string
+	var created []Subscription
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /event/subscription", func(w http.ResponseWriter, r *http.Request) {
+		writeTestJSON(w, http.StatusOK, ListResponseSubscription{Values: &[]Subscription{
+			{Id: ptr(int64(1)), Event: ptr("customer.create"), TargetUrl: ptr("https://example.com/hook"), Status: &active},
+			{Id: ptr(int64(2)), Event: ptr("customer.update"), TargetUrl: ptr("https://example.com/hook"), Status: &disabled},
+		}})
+	})
+	mux.HandleFunc("DELETE /event/subscription/{id}", func(w http.ResponseWriter, r *http.Request) {
+		deleted = append(deleted, r.PathValue("id"))
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("POST /event/subscription", func(w http.ResponseWriter, r *http.Request) {
+		var sub Subscription
+		require.NoError(json.NewDecoder(r.Body).Decode(&sub))
+		require.Equal("secret", *sub.AuthHeaderValue)
+		sub.Id = ptr(int64(10 + len(created)))
+		sub.Status = &active
+		created = append(created, sub)
+		writeTestJSON(w, http.StatusCreated, ResponseWrapperSubscription{Value: &sub})
+	})
+	c := newTestClient(t, mux)
+
+	spec := func(event EventKey) SubscriptionSpec {
+		return SubscriptionSpec{
+			Event:           event,
+			TargetUrl:       "https://example.com/hook",
+			AuthHeaderName:  "Authorization",
+			AuthHeaderValue: "secret",
+		}
+	}
+	subs, err := c.Subscriptions().Ensure(context.Background(), []SubscriptionSpec{
+		spec(EventCustomerCreate),
+		spec(EventCustomerUpdate),
+		spec(EventCustomerDelete),
+	})
+	require.NoError(err)
+	require.Equal([]string{"2"}, deleted)
+	require.Len(created, 2)
+	require.Equal("customer.update", *created[0].Event)
+	require.Equal("customer.delete", *created[1].Event)
+	require.Equal([]int64{1, 10, 11}, []int64{*subs[0].Id, *subs[1].Id, *subs[2].Id})
+}
+
+func TestSubscriptionEnsureWithoutId(t *testing.T) {
+	require := require.New(t)
+
+	disabled := DISABLEDTOOMANYERRORS
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /event/subscription", func(w http.ResponseWriter, r *http.Request) {
+		writeTestJSON(w, http.StatusOK, ListResponseSubscription{Values: &[]Subscription{
+			{Event: ptr("customer.create"), TargetUrl: ptr("https://example.com/hook"), Status: &disabled},
+		}})
+	})
+	c := newTestClient(t, mux)
+
+	_, err := c.Subscriptions().Ensure(context.Background(), []SubscriptionSpec{
+		{Event: EventCustomerCreate, TargetUrl: "https://example.com/hook"},
+	})
+	require.ErrorContains(err, "has no id")
+}
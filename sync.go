@@ -0,0 +1,284 @@
+package tripletex
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/valuetechdev/tripletex-go/auth"
+)
+
+// changedSinceLayout is the layout of changedSince query parameters.
+const changedSinceLayout = "2006-01-02T15:04:05"
+
+// defaultSyncOverlap is the default time a sync goes back beyond the last
+// checkpoint.
+const defaultSyncOverlap = 5 * time.Minute
+
+// CheckpointStore persists the time each resource was last synced by a
+// [SyncEngine].
+type CheckpointStore interface {
+	// Load returns the checkpoint of resource, or the zero time if it has
+	// never been synced.
+	Load(ctx context.Context, resource string) (time.Time, error)
+	// Save stores checkpoint as the checkpoint of resource.
+	Save(ctx context.Context, resource string, checkpoint time.Time) error
+}
+
+// MemoryCheckpointStore is a [CheckpointStore] keeping checkpoints in memory.
+// It's safe for concurrent use.
+type MemoryCheckpointStore struct {
+	mu          sync.Mutex
+	checkpoints map[string]time.Time
+}
+
+// NewMemoryCheckpointStore returns an empty [MemoryCheckpointStore].
+func NewMemoryCheckpointStore() *MemoryCheckpointStore {
+	return &MemoryCheckpointStore{checkpoints: make(map[string]time.Time)}
+}
+
+func (s *MemoryCheckpointStore) Load(ctx context.Context, resource string) (time.Time, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.checkpoints[resource], nil
+}
+
+func (s *MemoryCheckpointStore) Save(ctx context.Context, resource string, checkpoint time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.checkpoints[resource] = checkpoint
+	return nil
+}
+
+// SyncPage is a page of changes requested from a [SyncResource].
+type SyncPage struct {
+	Since  time.Time // Zero for a full sync
+	Fields string    // Empty for the default fields
+	From   int
+	Count  int
+}
+
+// SyncResource is a resource that can be fetched incrementally by a
+// [SyncEngine].
+//
+// Of the list endpoints, only customers and suppliers support changedSince,
+// see [CustomerSyncResource] and [SupplierSyncResource]. Other resources can
+// be synced with a Fetch that filters on eg. a date range instead.
+type SyncResource[T any] struct {
+	Name   string // Checkpoint key, must be unique within a [SyncEngine]
+	Fields string // Optional fields filter, eg. "id,version,name"
+
+	// Fetch returns a page of values changed since page.Since, with the
+	// [PageInfo] of the list response.
+	Fetch func(ctx context.Context, c *TripletexClient, page SyncPage) ([]T, PageInfo, error)
+	// Id returns the id of a value.
+	Id func(T) int64
+}
+
+// SyncChange is a changed value emitted by a [SyncEngine].
+type SyncChange[T any] struct {
+	Resource string
+	Id       int64
+	Value    T
+}
+
+// SyncOption configures a [SyncEngine].
+type SyncOption func(*SyncEngine)
+
+// WithSyncOverlap sets how far back beyond the last checkpoint a sync fetches
+// changes, to not miss changes because of clock skew between the client and
+// Tripletex. Values in the overlap are emitted again. Defaults to 5 minutes.
+func WithSyncOverlap(overlap time.Duration) SyncOption {
+	return func(e *SyncEngine) {
+		e.overlap = overlap
+	}
+}
+
+// WithSyncPageSize sets the number of values fetched per request. Defaults to
+// 1000.
+func WithSyncPageSize(size int) SyncOption {
+	return func(e *SyncEngine) {
+		e.pageSize = size
+	}
+}
+
+// SyncEngine fetches changes of registered resources since their last
+// checkpoint and emits them to handlers.
+//
+// Changes are delivered at least once: a value may be emitted again on the
+// next sync because of the overlap, or if the sync failed before its
+// checkpoint was saved. Handlers should therefore be idempotent.
+//
+// Use [TripletexClient.NewSyncEngine] to create one, and [RegisterSync] to
+// add resources.
+type SyncEngine struct {
+	client    *TripletexClient
+	store     CheckpointStore
+	overlap   time.Duration
+	pageSize  int
+	resources []syncRunner
+}
+
+// syncRunner syncs a single resource.
+type syncRunner struct {
+	name string
+	run  func(ctx context.Context, since time.Time) error
+}
+
+// NewSyncEngine returns a [SyncEngine] using c, saving checkpoints in store.
+func (c *TripletexClient) NewSyncEngine(store CheckpointStore, options ...SyncOption) *SyncEngine {
+	e := &SyncEngine{
+		client:   c,
+		store:    store,
+		overlap:  defaultSyncOverlap,
+		pageSize: defaultPageSize,
+	}
+	for _, option := range options {
+		option(e)
+	}
+	return e
+}
+
+// RegisterSync registers resource with e, emitting its changes to handler.
+//
+// If handler returns error the sync of resource stops, and its checkpoint is
+// not saved.
+func RegisterSync[T any](e *SyncEngine, resource SyncResource[T], handler func(ctx context.Context, change SyncChange[T]) error) {
+	e.resources = append(e.resources, syncRunner{
+		name: resource.Name,
+		run: func(ctx context.Context, since time.Time) error {
+			fetch := func(ctx context.Context, from, count int) ([]T, PageInfo, error) {
+				return resource.Fetch(ctx, e.client, SyncPage{
+					Since:  since,
+					Fields: resource.Fields,
+					From:   from,
+					Count:  count,
+				})
+			}
+			return forEachPage(ctx, e.pageSize, fetch, func(values []T) error {
+				for _, v := range values {
+					change := SyncChange[T]{Resource: resource.Name, Id: resource.Id(v), Value: v}
+					if err := handler(ctx, change); err != nil {
+						return fmt.Errorf("tripletex: sync: %s handler failed: %w", resource.Name, err)
+					}
+				}
+				return nil
+			})
+		},
+	})
+}
+
+// Run syncs each registered resource once, in the order they were
+// registered.
+//
+// Returns on the first error. Checkpoints of resources synced before the
+// error are saved.
+func (e *SyncEngine) Run(ctx context.Context) error {
+	for _, r := range e.resources {
+		if err := e.sync(ctx, r); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *SyncEngine) sync(ctx context.Context, r syncRunner) error {
+	checkpoint, err := e.store.Load(ctx, r.name)
+	if err != nil {
+		return fmt.Errorf("tripletex: sync: failed to load %s checkpoint: %w", r.name, err)
+	}
+	since := checkpoint
+	if !since.IsZero() {
+		since = since.Add(-e.overlap)
+	}
+
+	// Changes made while syncing are picked up by the next sync.
+	startedAt := e.client.now()
+	if err = r.run(ctx, since); err != nil {
+		return err
+	}
+
+	if err = e.store.Save(ctx, r.name, startedAt); err != nil {
+		return fmt.Errorf("tripletex: sync: failed to save %s checkpoint: %w", r.name, err)
+	}
+	return nil
+}
+
+// changedSince formats since as a changedSince query parameter, or returns nil
+// if since is zero.
+func changedSince(since time.Time) *string {
+	if since.IsZero() {
+		return nil
+	}
+	s := since.In(auth.Oslo).Format(changedSinceLayout)
+	return &s
+}
+
+// optionalFields returns nil if fields is empty.
+func optionalFields(fields string) *string {
+	if fields == "" {
+		return nil
+	}
+	return &fields
+}
+
+// CustomerSyncResource returns a [SyncResource] of customers.
+func CustomerSyncResource() SyncResource[Customer] {
+	return SyncResource[Customer]{
+		Name: "customer",
+		Fetch: func(ctx context.Context, c *TripletexClient, page SyncPage) ([]Customer, PageInfo, error) {
+			res, err := c.CustomerSearchWithResponse(ctx, &CustomerSearchParams{
+				ChangedSince: changedSince(page.Since),
+				Fields:       optionalFields(page.Fields),
+				From:         &page.From,
+				Count:        &page.Count,
+			})
+			if err != nil {
+				return nil, PageInfo{}, fmt.Errorf("tripletex: sync: failed to search customers: %w", err)
+			}
+			if err = checkResponse(res.HTTPResponse, res.Body); err != nil {
+				return nil, PageInfo{}, fmt.Errorf("tripletex: sync: failed to search customers: %w", err)
+			}
+			if res.JSONDefault == nil {
+				return nil, PageInfo{}, nil
+			}
+			return listValues(res.JSONDefault.Values), res.JSONDefault.PageInfo(), nil
+		},
+		Id: func(v Customer) int64 { return derefId(v.Id) },
+	}
+}
+
+// SupplierSyncResource returns a [SyncResource] of suppliers.
+func SupplierSyncResource() SyncResource[Supplier] {
+	return SyncResource[Supplier]{
+		Name: "supplier",
+		Fetch: func(ctx context.Context, c *TripletexClient, page SyncPage) ([]Supplier, PageInfo, error) {
+			res, err := c.SupplierSearchWithResponse(ctx, &SupplierSearchParams{
+				ChangedSince: changedSince(page.Since),
+				Fields:       optionalFields(page.Fields),
+				From:         &page.From,
+				Count:        &page.Count,
+			})
+			if err != nil {
+				return nil, PageInfo{}, fmt.Errorf("tripletex: sync: failed to search suppliers: %w", err)
+			}
+			if err = checkResponse(res.HTTPResponse, res.Body); err != nil {
+				return nil, PageInfo{}, fmt.Errorf("tripletex: sync: failed to search suppliers: %w", err)
+			}
+			if res.JSONDefault == nil {
+				return nil, PageInfo{}, nil
+			}
+			return listValues(res.JSONDefault.Values), res.JSONDefault.PageInfo(), nil
+		},
+		Id: func(v Supplier) int64 { return derefId(v.Id) },
+	}
+}
+
+// derefId returns the value of id, or 0 if id is nil.
+func derefId(id *int64) int64 {
+	if id == nil {
+		return 0
+	}
+	return *id
+}
@@ -0,0 +1,73 @@
+package tripletex
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSyncEngine(t *testing.T) {
+	require := require.New(t)
+
+	var changedSinceParams []string
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /customer", func(w http.ResponseWriter, r *http.Request) {
+		changedSinceParams = append(changedSinceParams, r.URL.Query().Get("changedSince"))
+		require.Equal("id,name", r.URL.Query().Get("fields"))
+		var customers []Customer
+		from, err := strconv.ParseInt(r.URL.Query().Get("from"), 10, 64)
+		require.NoError(err)
+		switch from {
+		case 0:
+			customers = []Customer{{Id: ptr(int64(1))}, {Id: ptr(int64(2))}}
+		case 2:
+			customers = []Customer{{Id: ptr(int64(3))}}
+		}
+		writeTestJSON(w, http.StatusOK, ListResponseCustomer{
+			From:           &from,
+			Count:          ptr(int64(len(customers))),
+			FullResultSize: ptr(int64(3)),
+			Values:         &customers,
+		})
+	})
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	c := newTestClient(t, mux, WithClock(func() time.Time { return now }))
+
+	store := NewMemoryCheckpointStore()
+	engine := c.NewSyncEngine(store, WithSyncPageSize(2), WithSyncOverlap(time.Minute))
+	resource := CustomerSyncResource()
+	resource.Fields = "id,name"
+	var ids []int64
+	failOn := int64(0)
+	RegisterSync(engine, resource, func(ctx context.Context, change SyncChange[Customer]) error {
+		if change.Id == failOn {
+			return errors.New("boom")
+		}
+		ids = append(ids, change.Id)
+		return nil
+	})
+	ctx := context.Background()
+
+	require.NoError(engine.Run(ctx))
+	require.Equal([]int64{1, 2, 3}, ids)
+	require.Equal([]string{"", ""}, changedSinceParams)
+
+	checkpoint, err := store.Load(ctx, "customer")
+	require.NoError(err)
+	require.Equal(now, checkpoint)
+
+	changedSinceParams = nil
+	ids = nil
+	failOn = 3
+	require.ErrorContains(engine.Run(ctx), "customer handler failed: boom")
+	require.Equal("2025-03-01T12:59:00", changedSinceParams[0], "checkpoint less overlap, in Oslo time")
+
+	failed, err := store.Load(ctx, "customer")
+	require.NoError(err)
+	require.Equal(checkpoint, failed)
+}
@@ -0,0 +1,304 @@
+package tripletex
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// TimesheetService groups the timesheet entry, week and month endpoints.
+//
+// Use [TripletexClient.Timesheets] to get one.
+type TimesheetService struct {
+	client *TripletexClient
+}
+
+// Timesheets returns a [TimesheetService] using c.
+func (c *TripletexClient) Timesheets() *TimesheetService {
+	return &TimesheetService{client: c}
+}
+
+// HourEntry is a number of hours on a project activity on a single day.
+//
+// The project and activity can be given by name or number instead of id, and
+// are then resolved with [TripletexClient.Resolver].
+type HourEntry struct {
+	Date       time.Time
+	ProjectId  int64  // Optional, 0 for activities without project
+	Project    string // Optional, name or number of the project if ProjectId is 0
+	ActivityId int64
+	Activity   string // Name or number of the activity if ActivityId is 0
+	Hours      float32
+	Comment    string
+}
+
+// WriteWeek writes entries for the employee with id employeeId, which must all
+// be in the same ISO week.
+//
+// Entries on the same date, project and activity as an existing timesheet
+// entry update it, while the rest are created, using the /timesheet/entry/list
+// endpoints.
+func (s *TimesheetService) WriteWeek(ctx context.Context, employeeId int64, entries []HourEntry) ([]TimesheetEntry, error) {
+	if len(entries) == 0 {
+		return nil, nil
+	}
+	year, week := entries[0].Date.ISOWeek()
+	for _, e := range entries[1:] {
+		if y, w := e.Date.ISOWeek(); y != year || w != week {
+			return nil, fmt.Errorf("tripletex: timesheet: entries span multiple weeks (%d-%02d and %d-%02d)", year, week, y, w)
+		}
+	}
+
+	start := startOfISOWeek(entries[0].Date)
+	existing, err := s.Entries(ctx, employeeId, start, start.AddDate(0, 0, 7))
+	if err != nil {
+		return nil, err
+	}
+	existingByKey := make(map[string]TimesheetEntry, len(existing))
+	for _, e := range existing {
+		existingByKey[timesheetEntryKey(e)] = e
+	}
+
+	var creates, updates []TimesheetEntry
+	for _, e := range entries {
+		if err = s.resolveHourEntry(ctx, &e); err != nil {
+			return nil, err
+		}
+		date := e.Date.Format(time.DateOnly)
+		entry := TimesheetEntry{
+			Employee: &Employee{Id: &employeeId},
+			Activity: &Activity{Id: &e.ActivityId},
+			Date:     &date,
+			Hours:    &e.Hours,
+			Comment:  &e.Comment,
+		}
+		if e.ProjectId != 0 {
+			entry.Project = &Project{Id: &e.ProjectId}
+		}
+
+		if found, ok := existingByKey[timesheetEntryKey(entry)]; ok {
+			entry.Id, entry.Version = found.Id, found.Version
+			updates = append(updates, entry)
+		} else {
+			creates = append(creates, entry)
+		}
+	}
+
+	var written []TimesheetEntry
+	if len(creates) > 0 {
+		created, err := s.writeList(ctx, creates, false)
+		if err != nil {
+			return nil, err
+		}
+		written = append(written, created...)
+	}
+	if len(updates) > 0 {
+		updated, err := s.writeList(ctx, updates, true)
+		if err != nil {
+			return written, err
+		}
+		written = append(written, updated...)
+	}
+	return written, nil
+}
+
+// WriteWeekFor is like [TimesheetService.WriteWeek] for the employee with
+// number, full name or email employee, resolved with
+// [TripletexClient.Resolver].
+func (s *TimesheetService) WriteWeekFor(ctx context.Context, employee string, entries []HourEntry) ([]TimesheetEntry, error) {
+	employeeId, err := s.client.Resolver().EmployeeId(ctx, employee)
+	if err != nil {
+		return nil, err
+	}
+	return s.WriteWeek(ctx, employeeId, entries)
+}
+
+// resolveHourEntry sets the project and activity ids of e from their names
+// or numbers.
+func (s *TimesheetService) resolveHourEntry(ctx context.Context, e *HourEntry) error {
+	var err error
+	if e.ProjectId == 0 && e.Project != "" {
+		if e.ProjectId, err = s.client.Resolver().ProjectId(ctx, e.Project); err != nil {
+			return err
+		}
+	}
+	if e.ActivityId == 0 && e.Activity != "" {
+		if e.ActivityId, err = s.client.Resolver().ActivityId(ctx, e.Activity); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Entries returns the timesheet entries of the employee with id employeeId
+// from dateFrom (inclusive) to dateTo (exclusive).
+func (s *TimesheetService) Entries(ctx context.Context, employeeId int64, dateFrom, dateTo time.Time) ([]TimesheetEntry, error) {
+	employee := strconv.FormatInt(employeeId, 10)
+	f := "id,version,date,hours,comment,employee(id),project(id),activity(id)"
+	return collectPages(ctx, 0, func(ctx context.Context, from, count int) ([]TimesheetEntry, PageInfo, error) {
+		res, err := s.client.TimesheetEntrySearchSearchWithResponse(ctx, &TimesheetEntrySearchSearchParams{
+			EmployeeId: &employee,
+			DateFrom:   dateFrom.Format(time.DateOnly),
+			DateTo:     dateTo.Format(time.DateOnly),
+			From:       &from,
+			Count:      &count,
+			Fields:     &f,
+		})
+		if err != nil {
+			return nil, PageInfo{}, fmt.Errorf("tripletex: timesheet: failed to search entries: %w", err)
+		}
+		if err = checkResponse(res.HTTPResponse, res.Body); err != nil {
+			return nil, PageInfo{}, fmt.Errorf("tripletex: timesheet: failed to search entries: %w", err)
+		}
+		if res.JSONDefault == nil {
+			return nil, PageInfo{}, nil
+		}
+		return listValues(res.JSONDefault.Values), res.JSONDefault.PageInfo(), nil
+	})
+}
+
+// writeList creates or updates entries with the /timesheet/entry/list
+// endpoints.
+func (s *TimesheetService) writeList(ctx context.Context, entries []TimesheetEntry, update bool) ([]TimesheetEntry, error) {
+	action := "create"
+	var (
+		httpRes *http.Response
+		resBody []byte
+		list    *ListResponseTimesheetEntry
+	)
+	if update {
+		action = "update"
+		res, err := s.client.TimesheetEntryListPutListWithApplicationJSONCharsetUTF8BodyWithResponse(ctx, entries)
+		if err != nil {
+			return nil, fmt.Errorf("tripletex: timesheet: failed to %s entries: %w", action, err)
+		}
+		httpRes, resBody, list = res.HTTPResponse, res.Body, res.JSONDefault
+	} else {
+		res, err := s.client.TimesheetEntryListPostListWithApplicationJSONCharsetUTF8BodyWithResponse(ctx, entries)
+		if err != nil {
+			return nil, fmt.Errorf("tripletex: timesheet: failed to %s entries: %w", action, err)
+		}
+		httpRes, resBody, list = res.HTTPResponse, res.Body, res.JSONDefault
+	}
+	if err := checkResponse(httpRes, resBody); err != nil {
+		return nil, fmt.Errorf("tripletex: timesheet: failed to %s entries: %w", action, err)
+	}
+	if list == nil {
+		return nil, nil
+	}
+	return listValues(list.Values), nil
+}
+
+// CompleteWeek marks the ISO week containing week as completed for the
+// employee with id employeeId.
+func (s *TimesheetService) CompleteWeek(ctx context.Context, employeeId int64, week time.Time) error {
+	weekYear := isoWeekYear(week)
+	res, err := s.client.TimesheetWeekCompleteCompleteWithResponse(ctx, &TimesheetWeekCompleteCompleteParams{
+		EmployeeId: &employeeId,
+		WeekYear:   &weekYear,
+	})
+	if err != nil {
+		return fmt.Errorf("tripletex: timesheet: failed to complete week %s: %w", weekYear, err)
+	}
+	if err = checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return fmt.Errorf("tripletex: timesheet: failed to complete week %s: %w", weekYear, err)
+	}
+	return nil
+}
+
+// ApproveWeek approves the ISO week containing week for the employee with id
+// employeeId.
+func (s *TimesheetService) ApproveWeek(ctx context.Context, employeeId int64, week time.Time) error {
+	weekYear := isoWeekYear(week)
+	res, err := s.client.TimesheetWeekApproveApproveWithResponse(ctx, &TimesheetWeekApproveApproveParams{
+		EmployeeId: &employeeId,
+		WeekYear:   &weekYear,
+	})
+	if err != nil {
+		return fmt.Errorf("tripletex: timesheet: failed to approve week %s: %w", weekYear, err)
+	}
+	if err = checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return fmt.Errorf("tripletex: timesheet: failed to approve week %s: %w", weekYear, err)
+	}
+	return nil
+}
+
+// CompleteMonth marks the month containing month as completed for the
+// employees with employeeIds, or the token owner if none are given.
+func (s *TimesheetService) CompleteMonth(ctx context.Context, month time.Time, employeeIds ...int64) error {
+	monthYear := month.Format("2006-01")
+	params := &TimesheetMonthCompleteCompleteParams{MonthYear: &monthYear}
+	if len(employeeIds) > 0 {
+		ids := joinIds(employeeIds)
+		params.EmployeeIds = &ids
+	}
+	res, err := s.client.TimesheetMonthCompleteCompleteWithResponse(ctx, params)
+	if err != nil {
+		return fmt.Errorf("tripletex: timesheet: failed to complete month %s: %w", monthYear, err)
+	}
+	if err = checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return fmt.Errorf("tripletex: timesheet: failed to complete month %s: %w", monthYear, err)
+	}
+	return nil
+}
+
+// ApproveMonth approves the month containing month for the employees with
+// employeeIds, or the token owner if none are given.
+func (s *TimesheetService) ApproveMonth(ctx context.Context, month time.Time, employeeIds ...int64) error {
+	monthYear := month.Format("2006-01")
+	params := &TimesheetMonthApproveApproveParams{MonthYear: &monthYear}
+	if len(employeeIds) > 0 {
+		ids := joinIds(employeeIds)
+		params.EmployeeIds = &ids
+	}
+	res, err := s.client.TimesheetMonthApproveApproveWithResponse(ctx, params)
+	if err != nil {
+		return fmt.Errorf("tripletex: timesheet: failed to approve month %s: %w", monthYear, err)
+	}
+	if err = checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return fmt.Errorf("tripletex: timesheet: failed to approve month %s: %w", monthYear, err)
+	}
+	return nil
+}
+
+// timesheetEntryKey identifies the date, project and activity of e, which
+// Tripletex allows one entry per employee for.
+func timesheetEntryKey(e TimesheetEntry) string {
+	var date string
+	var projectId, activityId int64
+	if e.Date != nil {
+		date = *e.Date
+	}
+	if e.Project != nil && e.Project.Id != nil {
+		projectId = *e.Project.Id
+	}
+	if e.Activity != nil && e.Activity.Id != nil {
+		activityId = *e.Activity.Id
+	}
+	return fmt.Sprintf("%s/%d/%d", date, projectId, activityId)
+}
+
+// startOfISOWeek returns midnight on the Monday of the ISO week containing t.
+func startOfISOWeek(t time.Time) time.Time {
+	offset := (int(t.Weekday()) + 6) % 7
+	y, m, d := t.AddDate(0, 0, -offset).Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+}
+
+// isoWeekYear formats the ISO week of t as expected by Tripletex, eg. "2018-12".
+func isoWeekYear(t time.Time) string {
+	year, week := t.ISOWeek()
+	return fmt.Sprintf("%d-%02d", year, week)
+}
+
+// joinIds formats ids as a comma separated list.
+func joinIds(ids []int64) string {
+	s := make([]string, len(ids))
+	for i, id := range ids {
+		s[i] = strconv.FormatInt(id, 10)
+	}
+	return strings.Join(s, ",")
+}
@@ -0,0 +1,106 @@
+package tripletex
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTimesheetWriteWeek(t *testing.T) {
+	require := require.New(t)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /timesheet/entry", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal("2025-03-03", r.URL.Query().Get("dateFrom"))
+		require.Equal("2025-03-10", r.URL.Query().Get("dateTo"))
+		writeTestJSON(w, http.StatusOK, ListResponseTimesheetEntry{Values: &[]TimesheetEntry{{
+			Id:       ptr(int64(50)),
+			Version:  ptr(int32(1)),
+			Date:     ptr("2025-03-04"),
+			Project:  &Project{Id: ptr(int64(2))},
+			Activity: &Activity{Id: ptr(int64(3))},
+		}}})
+	})
+	mux.HandleFunc("POST /timesheet/entry/list", func(w http.ResponseWriter, r *http.Request) {
+		var entries []TimesheetEntry
+		require.NoError(json.NewDecoder(r.Body).Decode(&entries))
+		require.Len(entries, 1)
+		require.Nil(entries[0].Id)
+		writeTestJSON(w, http.StatusCreated, ListResponseTimesheetEntry{Values: &entries})
+	})
+	mux.HandleFunc("PUT /timesheet/entry/list", func(w http.ResponseWriter, r *http.Request) {
+		var entries []TimesheetEntry
+		require.NoError(json.NewDecoder(r.Body).Decode(&entries))
+		require.Len(entries, 1)
+		require.Equal(int64(50), *entries[0].Id)
+		require.Equal(int32(1), *entries[0].Version)
+		writeTestJSON(w, http.StatusOK, ListResponseTimesheetEntry{Values: &entries})
+	})
+	c := newTestClient(t, mux)
+
+	entries, err := c.Timesheets().WriteWeek(context.Background(), 1, []HourEntry{
+		{Date: time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC), ProjectId: 2, ActivityId: 3, Hours: 7.5},
+		{Date: time.Date(2025, 3, 4, 0, 0, 0, 0, time.UTC), ProjectId: 2, ActivityId: 3, Hours: 4},
+	})
+	require.NoError(err)
+	require.Len(entries, 2)
+
+	_, err = c.Timesheets().WriteWeek(context.Background(), 1, []HourEntry{
+		{Date: time.Date(2025, 3, 9, 0, 0, 0, 0, time.UTC)},
+		{Date: time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC)},
+	})
+	require.ErrorContains(err, "multiple weeks")
+}
+
+func TestISOWeekHelpers(t *testing.T) {
+	require := require.New(t)
+
+	sunday := time.Date(2025, 3, 9, 15, 0, 0, 0, time.UTC)
+	require.Equal(time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC), startOfISOWeek(sunday))
+	require.Equal("2025-10", isoWeekYear(sunday))
+	require.Equal("2025-01", isoWeekYear(time.Date(2024, 12, 30, 0, 0, 0, 0, time.UTC)))
+}
+
+func TestTimesheetWriteWeekFor(t *testing.T) {
+	require := require.New(t)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /employee", func(w http.ResponseWriter, r *http.Request) {
+		writeTestJSON(w, http.StatusOK, ListResponseEmployee{Values: &[]Employee{{Id: ptr(int64(1)), FirstName: ptr("Ola"), LastName: ptr("Nordmann")}}})
+	})
+	mux.HandleFunc("GET /project", func(w http.ResponseWriter, r *http.Request) {
+		writeTestJSON(w, http.StatusOK, ListResponseProject{Values: &[]Project{{Id: ptr(int64(2)), Number: ptr("P2"), Name: ptr("Website")}}})
+	})
+	mux.HandleFunc("GET /activity", func(w http.ResponseWriter, r *http.Request) {
+		writeTestJSON(w, http.StatusOK, ListResponseActivity{Values: &[]Activity{{Id: ptr(int64(3)), Name: ptr("Development")}}})
+	})
+	mux.HandleFunc("GET /timesheet/entry", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal("1", r.URL.Query().Get("employeeId"))
+		writeTestJSON(w, http.StatusOK, ListResponseTimesheetEntry{})
+	})
+	mux.HandleFunc("POST /timesheet/entry/list", func(w http.ResponseWriter, r *http.Request) {
+		var entries []TimesheetEntry
+		require.NoError(json.NewDecoder(r.Body).Decode(&entries))
+		require.Len(entries, 1)
+		require.Equal(int64(1), *entries[0].Employee.Id)
+		require.Equal(int64(2), *entries[0].Project.Id)
+		require.Equal(int64(3), *entries[0].Activity.Id)
+		writeTestJSON(w, http.StatusCreated, ListResponseTimesheetEntry{Values: &entries})
+	})
+	c := newTestClient(t, mux)
+
+	entries, err := c.Timesheets().WriteWeekFor(context.Background(), "Ola Nordmann", []HourEntry{
+		{Date: time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC), Project: "P2", Activity: "development", Hours: 7.5},
+	})
+	require.NoError(err)
+	require.Len(entries, 1)
+
+	_, err = c.Timesheets().WriteWeekFor(context.Background(), "Ola Nordmann", []HourEntry{
+		{Date: time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC), Activity: "Meeting", Hours: 1},
+	})
+	require.ErrorIs(err, ErrNotFound)
+}
@@ -0,0 +1,198 @@
+package tripletex
+
+import (
+	"context"
+	"fmt"
+	"io"
+)
+
+// TravelExpenseService groups the travel expense, cost and attachment
+// endpoints.
+//
+// Use [TripletexClient.TravelExpenses] to get one.
+type TravelExpenseService struct {
+	client *TripletexClient
+}
+
+// TravelExpenses returns a [TravelExpenseService] using c.
+func (c *TripletexClient) TravelExpenses() *TravelExpenseService {
+	return &TravelExpenseService{client: c}
+}
+
+// Receipt is a file attached to a travel expense.
+type Receipt struct {
+	FileName string
+	Content  io.Reader
+}
+
+// TravelExpenseSubmission is the input to [TravelExpenseService.Submit].
+//
+// The employee, project and department can be given by name or number
+// instead of on the travel expense, and are then resolved with
+// [TripletexClient.Resolver].
+type TravelExpenseSubmission struct {
+	TravelExpense TravelExpense
+	Employee      string // Optional, number, full name or email if TravelExpense.Employee is nil
+	Project       string // Optional, name or number if TravelExpense.Project is nil
+	Department    string // Optional, name or number if TravelExpense.Department is nil
+	Costs         []Cost
+	Receipts      []Receipt
+	Deliver       bool // Deliver the travel expense for approval when done
+}
+
+// Submit creates a travel expense with its costs and receipts, and delivers it
+// if [TravelExpenseSubmission.Deliver] is set.
+//
+// If a step after creating the travel expense fails, the created travel
+// expense is returned along with the error, so that it can be completed or
+// deleted by the caller.
+func (s *TravelExpenseService) Submit(ctx context.Context, submission TravelExpenseSubmission) (*TravelExpense, error) {
+	if err := s.resolveSubmission(ctx, &submission); err != nil {
+		return nil, err
+	}
+	expense, err := s.Create(ctx, submission.TravelExpense)
+	if err != nil {
+		return nil, err
+	}
+	id := *expense.Id
+
+	costs := make([]Cost, 0, len(submission.Costs))
+	for _, cost := range submission.Costs {
+		created, err := s.AddCost(ctx, id, cost)
+		if err != nil {
+			return expense, err
+		}
+		costs = append(costs, *created)
+	}
+	expense.Costs = &costs
+
+	for _, receipt := range submission.Receipts {
+		if err = s.AttachReceipt(ctx, id, receipt, false); err != nil {
+			return expense, err
+		}
+	}
+
+	if submission.Deliver {
+		delivered, err := s.Deliver(ctx, id)
+		if err != nil {
+			return expense, err
+		}
+		if len(delivered) == 1 {
+			delivered[0].Costs = expense.Costs
+			return &delivered[0], nil
+		}
+	}
+	return expense, nil
+}
+
+// resolveSubmission sets the employee, project and department of the travel
+// expense of submission from their names or numbers.
+func (s *TravelExpenseService) resolveSubmission(ctx context.Context, submission *TravelExpenseSubmission) error {
+	expense, resolver := &submission.TravelExpense, s.client.Resolver()
+	if expense.Employee == nil && submission.Employee != "" {
+		id, err := resolver.EmployeeId(ctx, submission.Employee)
+		if err != nil {
+			return err
+		}
+		expense.Employee = &Employee{Id: &id}
+	}
+	if expense.Project == nil && submission.Project != "" {
+		id, err := resolver.ProjectId(ctx, submission.Project)
+		if err != nil {
+			return err
+		}
+		expense.Project = &Project{Id: &id}
+	}
+	if expense.Department == nil && submission.Department != "" {
+		id, err := resolver.DepartmentId(ctx, submission.Department)
+		if err != nil {
+			return err
+		}
+		expense.Department = &Department{Id: &id}
+	}
+	return nil
+}
+
+// Create creates expense.
+func (s *TravelExpenseService) Create(ctx context.Context, expense TravelExpense) (*TravelExpense, error) {
+	res, err := s.client.TravelExpensePostWithApplicationJSONCharsetUTF8BodyWithResponse(ctx, expense)
+	if err != nil {
+		return nil, fmt.Errorf("tripletex: travel expense: failed to create: %w", err)
+	}
+	if err = checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return nil, fmt.Errorf("tripletex: travel expense: failed to create: %w", err)
+	}
+	if res.JSONDefault == nil || res.JSONDefault.Value == nil || res.JSONDefault.Value.Id == nil {
+		return nil, fmt.Errorf("tripletex: travel expense: created travel expense is empty")
+	}
+	return res.JSONDefault.Value, nil
+}
+
+// AddCost adds cost to the travel expense with id travelExpenseId.
+func (s *TravelExpenseService) AddCost(ctx context.Context, travelExpenseId int64, cost Cost) (*Cost, error) {
+	cost.TravelExpense = &TravelExpense{Id: &travelExpenseId}
+	res, err := s.client.TravelExpenseCostPostWithApplicationJSONCharsetUTF8BodyWithResponse(ctx, cost)
+	if err != nil {
+		return nil, fmt.Errorf("tripletex: travel expense: failed to add cost to %d: %w", travelExpenseId, err)
+	}
+	if err = checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return nil, fmt.Errorf("tripletex: travel expense: failed to add cost to %d: %w", travelExpenseId, err)
+	}
+	if res.JSONDefault == nil || res.JSONDefault.Value == nil {
+		return nil, fmt.Errorf("tripletex: travel expense: created cost is empty")
+	}
+	return res.JSONDefault.Value, nil
+}
+
+// AttachReceipt uploads receipt to the travel expense with id
+// travelExpenseId. If createNewCost is true, Tripletex creates a cost line for
+// the receipt.
+func (s *TravelExpenseService) AttachReceipt(ctx context.Context, travelExpenseId int64, receipt Receipt, createNewCost bool) error {
+	body, contentType, err := multipartFile(receipt.FileName, receipt.Content)
+	if err != nil {
+		return fmt.Errorf("tripletex: travel expense: %w", err)
+	}
+
+	res, err := s.client.TravelExpenseAttachmentUploadAttachmentWithBodyWithResponse(ctx, travelExpenseId, &TravelExpenseAttachmentUploadAttachmentParams{
+		CreateNewCost: &createNewCost,
+	}, contentType, body)
+	if err != nil {
+		return fmt.Errorf("tripletex: travel expense: failed to attach %q to %d: %w", receipt.FileName, travelExpenseId, err)
+	}
+	if err = checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return fmt.Errorf("tripletex: travel expense: failed to attach %q to %d: %w", receipt.FileName, travelExpenseId, err)
+	}
+	return nil
+}
+
+// Deliver delivers the travel expenses with ids for approval.
+func (s *TravelExpenseService) Deliver(ctx context.Context, ids ...int64) ([]TravelExpense, error) {
+	idList := joinIds(ids)
+	res, err := s.client.TravelExpenseDeliverDeliverWithResponse(ctx, &TravelExpenseDeliverDeliverParams{Id: &idList})
+	if err != nil {
+		return nil, fmt.Errorf("tripletex: travel expense: failed to deliver %s: %w", idList, err)
+	}
+	if err = checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return nil, fmt.Errorf("tripletex: travel expense: failed to deliver %s: %w", idList, err)
+	}
+	if res.JSONDefault == nil {
+		return nil, nil
+	}
+	return listValues(res.JSONDefault.Values), nil
+}
+
+// Approve approves the delivered travel expenses with ids.
+func (s *TravelExpenseService) Approve(ctx context.Context, ids ...int64) ([]TravelExpense, error) {
+	idList := joinIds(ids)
+	res, err := s.client.TravelExpenseApproveApproveWithResponse(ctx, &TravelExpenseApproveApproveParams{Id: &idList})
+	if err != nil {
+		return nil, fmt.Errorf("tripletex: travel expense: failed to approve %s: %w", idList, err)
+	}
+	if err = checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return nil, fmt.Errorf("tripletex: travel expense: failed to approve %s: %w", idList, err)
+	}
+	if res.JSONDefault == nil {
+		return nil, nil
+	}
+	return listValues(res.JSONDefault.Values), nil
+}
@@ -0,0 +1,92 @@
+package tripletex
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTravelExpenseSubmit(t *testing.T) {
+	require := require.New(t)
+
+	var steps []string
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /travelExpense", func(w http.ResponseWriter, r *http.Request) {
+		steps = append(steps, "create")
+		writeTestJSON(w, http.StatusCreated, ResponseWrapperTravelExpense{Value: &TravelExpense{Id: ptr(int64(20))}})
+	})
+	mux.HandleFunc("POST /travelExpense/cost", func(w http.ResponseWriter, r *http.Request) {
+		steps = append(steps, "cost")
+		var cost Cost
+		require.NoError(json.NewDecoder(r.Body).Decode(&cost))
+		require.Equal(int64(20), *cost.TravelExpense.Id)
+		writeTestJSON(w, http.StatusCreated, ResponseWrapperCost{Value: &cost})
+	})
+	mux.HandleFunc("POST /travelExpense/{id}/attachment", func(w http.ResponseWriter, r *http.Request) {
+		steps = append(steps, "attachment")
+		require.Equal("20", r.PathValue("id"))
+		f, h, err := r.FormFile("file")
+		require.NoError(err)
+		require.Equal("taxi.pdf", h.Filename)
+		b, err := io.ReadAll(f)
+		require.NoError(err)
+		require.Equal("%PDF", string(b))
+		w.WriteHeader(http.StatusOK)
+	})
+	mux.HandleFunc("PUT /travelExpense/:deliver", func(w http.ResponseWriter, r *http.Request) {
+		steps = append(steps, "deliver")
+		require.Equal("20", r.URL.Query().Get("id"))
+		writeTestJSON(w, http.StatusOK, ListResponseTravelExpense{Values: &[]TravelExpense{{Id: ptr(int64(20)), IsCompleted: ptr(true)}}})
+	})
+	c := newTestClient(t, mux)
+
+	expense, err := c.TravelExpenses().Submit(context.Background(), TravelExpenseSubmission{
+		TravelExpense: TravelExpense{Title: ptr("Client visit")},
+		Costs:         []Cost{{AmountCurrencyIncVat: ptr(float32(250))}},
+		Receipts:      []Receipt{{FileName: "taxi.pdf", Content: strings.NewReader("%PDF")}},
+		Deliver:       true,
+	})
+	require.NoError(err)
+	require.Equal([]string{"create", "cost", "attachment", "deliver"}, steps)
+	require.True(*expense.IsCompleted)
+	require.Len(*expense.Costs, 1)
+}
+
+func TestTravelExpenseSubmitResolvesNames(t *testing.T) {
+	require := require.New(t)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /employee", func(w http.ResponseWriter, r *http.Request) {
+		writeTestJSON(w, http.StatusOK, ListResponseEmployee{Values: &[]Employee{{Id: ptr(int64(1)), Email: ptr("ola@example.com")}}})
+	})
+	mux.HandleFunc("GET /project", func(w http.ResponseWriter, r *http.Request) {
+		writeTestJSON(w, http.StatusOK, ListResponseProject{Values: &[]Project{{Id: ptr(int64(2)), Name: ptr("Website")}}})
+	})
+	mux.HandleFunc("GET /department", func(w http.ResponseWriter, r *http.Request) {
+		writeTestJSON(w, http.StatusOK, ListResponseDepartment{Values: &[]Department{{Id: ptr(int64(3)), DepartmentNumber: ptr("10"), Name: ptr("Sales")}}})
+	})
+	mux.HandleFunc("POST /travelExpense", func(w http.ResponseWriter, r *http.Request) {
+		var expense TravelExpense
+		require.NoError(json.NewDecoder(r.Body).Decode(&expense))
+		require.Equal(int64(1), *expense.Employee.Id)
+		require.Equal(int64(2), *expense.Project.Id)
+		require.Equal(int64(3), *expense.Department.Id)
+		expense.Id = ptr(int64(20))
+		writeTestJSON(w, http.StatusCreated, ResponseWrapperTravelExpense{Value: &expense})
+	})
+	c := newTestClient(t, mux)
+
+	expense, err := c.TravelExpenses().Submit(context.Background(), TravelExpenseSubmission{
+		TravelExpense: TravelExpense{Title: ptr("Client visit")},
+		Employee:      "ola@example.com",
+		Project:       "website",
+		Department:    "10",
+	})
+	require.NoError(err)
+	require.Equal(int64(20), *expense.Id)
+}
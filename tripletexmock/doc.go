@@ -0,0 +1,10 @@
+//go:generate go tool -modfile=../go.tool.mod go.uber.org/mock/mockgen -destination=mock.gen.go -package=tripletexmock -mock_names=TripletexAPI=MockTripletexAPI -write_package_comment=false github.com/valuetechdev/tripletex-go TripletexAPI
+
+// Package tripletexmock provides a [gomock] mock of [tripletex.TripletexAPI].
+//
+//	ctrl := gomock.NewController(t)
+//	api := tripletexmock.NewMockTripletexAPI(ctrl)
+//	api.EXPECT().CustomerGetWithResponse(gomock.Any(), int64(1), gomock.Any()).Return(res, nil)
+//
+// [gomock]: https://pkg.go.dev/go.uber.org/mock/gomock
+package tripletexmock
@@ -0,0 +1,28 @@
+package tripletexmock
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/valuetechdev/tripletex-go"
+	"go.uber.org/mock/gomock"
+)
+
+var _ tripletex.TripletexAPI = (*MockTripletexAPI)(nil)
+
+func TestMockTripletexAPI(t *testing.T) {
+	require := require.New(t)
+
+	ctrl := gomock.NewController(t)
+	api := NewMockTripletexAPI(ctrl)
+	name := "Acme AS"
+	api.EXPECT().
+		CustomerGetWithResponse(gomock.Any(), int64(1), gomock.Any()).
+		Return(&tripletex.CustomerGetResponse{JSONDefault: &tripletex.ResponseWrapperCustomer{Value: &tripletex.Customer{Name: &name}}}, nil)
+
+	var client tripletex.TripletexAPI = api
+	res, err := client.CustomerGetWithResponse(context.Background(), 1, &tripletex.CustomerGetParams{})
+	require.NoError(err)
+	require.Equal(name, *res.JSONDefault.Value.Name)
+}
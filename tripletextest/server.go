@@ -0,0 +1,466 @@
+// Package tripletextest provides an in-memory fake of the Tripletex API, for
+// testing integrations without a Tripletex account.
+//
+//	srv := tripletextest.NewServer()
+//	defer srv.Close()
+//	client := srv.Client()
+//
+// The fake implements session token creation, whoAmI, and CRUD of a set of
+// entities, see [WithEntities]. It does not implement business rules, eg.
+// required fields or relations between entities.
+package tripletextest
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/valuetechdev/tripletex-go"
+)
+
+// DefaultEntities are the entities served by default.
+var DefaultEntities = []string{
+	"customer",
+	"supplier",
+	"contact",
+	"product",
+	"employee",
+	"department",
+	"project",
+	"order",
+}
+
+// Option configures a [Server].
+type Option func(*Server)
+
+// WithEntities sets the entities served, as their path without leading
+// slash, eg. "customer" or "ledger/account". Defaults to [DefaultEntities].
+func WithEntities(entities ...string) Option {
+	return func(s *Server) {
+		s.entities = entities
+	}
+}
+
+// WithWhoAmI sets the response of /token/session/>whoAmI. Defaults to
+// employee 1 of company 1.
+func WithWhoAmI(info tripletex.LoggedInUserInfo) Option {
+	return func(s *Server) {
+		s.whoAmI = info
+	}
+}
+
+// Server is a fake Tripletex API, serving over HTTP on a local loopback
+// interface.
+//
+// Entities are stored as JSON objects, keyed by id. Ids are assigned in
+// increasing order across all entities, and versions start at 0 and are
+// incremented on each update.
+type Server struct {
+	URL string // Base URL, eg. http://127.0.0.1:1234/v2
+
+	server   *httptest.Server
+	entities []string
+	whoAmI   tripletex.LoggedInUserInfo
+
+	mu     sync.Mutex
+	nextId int64
+	tokens map[string]bool
+	stores map[string]map[int64]map[string]any
+}
+
+// NewServer starts and returns a new [Server]. The caller should call
+// [Server.Close] when finished, to shut it down.
+func NewServer(options ...Option) *Server {
+	employeeId, companyId := int32(1), int32(1)
+	s := &Server{
+		entities: DefaultEntities,
+		whoAmI: tripletex.LoggedInUserInfo{
+			EmployeeId: &employeeId,
+			CompanyId:  &companyId,
+		},
+		nextId: 1,
+		tokens: make(map[string]bool),
+		stores: make(map[string]map[int64]map[string]any),
+	}
+	for _, option := range options {
+		option(s)
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("PUT /v2/token/session/:create", s.createToken)
+	mux.HandleFunc("GET /v2/token/session/>whoAmI", s.authenticated(s.getWhoAmI))
+	for _, entity := range s.entities {
+		s.stores[entity] = make(map[int64]map[string]any)
+		prefix := "/v2/" + entity
+		mux.HandleFunc("GET "+prefix, s.authenticated(s.search(entity)))
+		mux.HandleFunc("POST "+prefix, s.authenticated(s.create(entity)))
+		mux.HandleFunc("POST "+prefix+"/list", s.authenticated(s.createList(entity)))
+		mux.HandleFunc("PUT "+prefix+"/list", s.authenticated(s.updateList(entity)))
+		mux.HandleFunc("GET "+prefix+"/{id}", s.authenticated(s.get(entity)))
+		mux.HandleFunc("PUT "+prefix+"/{id}", s.authenticated(s.update(entity)))
+		mux.HandleFunc("DELETE "+prefix+"/{id}", s.authenticated(s.delete(entity)))
+	}
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("%s %s is not implemented by tripletextest", r.Method, r.URL.Path))
+	})
+
+	s.server = httptest.NewServer(mux)
+	s.URL = s.server.URL + "/v2"
+	return s
+}
+
+// Close shuts down the server.
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// Client returns a [tripletex.TripletexClient] using s, configured with
+// options.
+func (s *Server) Client(options ...tripletex.Option) *tripletex.TripletexClient {
+	creds := tripletex.Credentials{ConsumerToken: "consumer", EmployeeToken: "employee"}
+	return tripletex.New(creds, append([]tripletex.Option{tripletex.WithBaseURLOption(s.URL)}, options...)...)
+}
+
+// Seed stores value, eg. a [tripletex.Customer], as entity and returns its
+// assigned id.
+//
+// Panics if entity is not served or value isn't a JSON object.
+func (s *Server) Seed(entity string, value any) int64 {
+	obj, err := toObject(value)
+	if err != nil {
+		panic(fmt.Sprintf("tripletextest: failed to seed %s: %v", entity, err))
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.stores[entity]; !ok {
+		panic(fmt.Sprintf("tripletextest: entity %q is not served", entity))
+	}
+	return s.insert(entity, obj)
+}
+
+// Get decodes the entity with id into v, eg. a *[tripletex.Customer].
+// Reports whether it exists.
+func (s *Server) Get(entity string, id int64, v any) bool {
+	s.mu.Lock()
+	obj, ok := s.stores[entity][id]
+	var b []byte
+	if ok {
+		b, _ = json.Marshal(obj)
+	}
+	s.mu.Unlock()
+	if !ok {
+		return false
+	}
+	if err := json.Unmarshal(b, v); err != nil {
+		panic(fmt.Sprintf("tripletextest: failed to decode %s %d: %v", entity, id, err))
+	}
+	return true
+}
+
+// insert stores obj with a new id and version 0. s.mu must be held.
+func (s *Server) insert(entity string, obj map[string]any) int64 {
+	id := s.nextId
+	s.nextId++
+	obj["id"] = id
+	obj["version"] = 0
+	s.stores[entity][id] = obj
+	return id
+}
+
+// merge sets the fields of obj on the entity with id and increments its
+// version. s.mu must be held.
+func (s *Server) merge(entity string, id int64, obj map[string]any) (map[string]any, bool) {
+	current, ok := s.stores[entity][id]
+	if !ok {
+		return nil, false
+	}
+	version, _ := current["version"].(int)
+	maps.Copy(current, obj)
+	current["id"] = id
+	current["version"] = version + 1
+	return current, true
+}
+
+// authenticated requires requests to next to use a session token created
+// with s.
+func (s *Server) authenticated(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		_, token, ok := r.BasicAuth()
+		s.mu.Lock()
+		valid := ok && s.tokens[token]
+		s.mu.Unlock()
+		if !valid {
+			writeError(w, http.StatusUnauthorized, "Unauthorized")
+			return
+		}
+		next(w, r)
+	}
+}
+
+func (s *Server) createToken(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	if q.Get("consumerToken") == "" || q.Get("employeeToken") == "" {
+		writeError(w, http.StatusForbidden, "Invalid or missing consumerToken or employeeToken")
+		return
+	}
+	expirationDate := q.Get("expirationDate")
+	if _, err := time.Parse(time.DateOnly, expirationDate); err != nil {
+		writeError(w, http.StatusUnprocessableEntity, "Invalid expirationDate")
+		return
+	}
+
+	s.mu.Lock()
+	token := fmt.Sprintf("tripletextest-token-%d", len(s.tokens)+1)
+	s.tokens[token] = true
+	s.mu.Unlock()
+
+	writeJSON(w, http.StatusOK, tripletex.ResponseWrapperSessionToken{Value: &tripletex.SessionToken{
+		Token:          &token,
+		ExpirationDate: &expirationDate,
+	}})
+}
+
+func (s *Server) getWhoAmI(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, tripletex.ResponseWrapperLoggedInUserInfo{Value: &s.whoAmI})
+}
+
+// search lists entities ordered by id, paged with from and count.
+//
+// Other query parameters, except fields, sorting and changedSince, filter on
+// the top-level field with the same name, case-insensitively, with "id"
+// taking a comma separated list. Entities without the field don't match.
+func (s *Server) search(entity string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		from, _ := strconv.Atoi(q.Get("from"))
+		count, err := strconv.Atoi(q.Get("count"))
+		if err != nil {
+			count = 1000
+		}
+
+		s.mu.Lock()
+		store := s.stores[entity]
+		var values []map[string]any
+		for _, id := range slices.Sorted(maps.Keys(store)) {
+			if matches(store[id], q) {
+				values = append(values, store[id])
+			}
+		}
+		total := len(values)
+		values = values[min(from, total):min(from+count, total)]
+		if values == nil {
+			values = []map[string]any{}
+		}
+		b, err := json.Marshal(map[string]any{
+			"fullResultSize": total,
+			"from":           from,
+			"count":          len(values),
+			"values":         values,
+		})
+		s.mu.Unlock()
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		writeRaw(w, http.StatusOK, b)
+	}
+}
+
+// unfilteredParams are the query parameters search doesn't filter on.
+var unfilteredParams = []string{"from", "count", "fields", "sorting", "changedSince"}
+
+// matches reports whether obj matches the filters in q.
+func matches(obj map[string]any, q map[string][]string) bool {
+	for key, values := range q {
+		if slices.Contains(unfilteredParams, key) {
+			continue
+		}
+		field, ok := obj[key]
+		if !ok {
+			return false
+		}
+		value := fmt.Sprint(field)
+		if key == "id" {
+			if !slices.Contains(strings.Split(values[0], ","), value) {
+				return false
+			}
+			continue
+		}
+		if !strings.EqualFold(value, values[0]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *Server) get(entity string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, ok := pathId(w, r)
+		if !ok {
+			return
+		}
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		obj, ok := s.stores[entity][id]
+		if !ok {
+			writeError(w, http.StatusNotFound, fmt.Sprintf("Object not found: %s %d", entity, id))
+			return
+		}
+		writeJSON(w, http.StatusOK, map[string]any{"value": obj})
+	}
+}
+
+func (s *Server) create(entity string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var obj map[string]any
+		if !decodeBody(w, r, &obj) {
+			return
+		}
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.insert(entity, obj)
+		writeJSON(w, http.StatusCreated, map[string]any{"value": obj})
+	}
+}
+
+func (s *Server) createList(entity string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var objs []map[string]any
+		if !decodeBody(w, r, &objs) {
+			return
+		}
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		for _, obj := range objs {
+			s.insert(entity, obj)
+		}
+		writeJSON(w, http.StatusCreated, map[string]any{"values": objs})
+	}
+}
+
+func (s *Server) update(entity string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, ok := pathId(w, r)
+		if !ok {
+			return
+		}
+		var obj map[string]any
+		if !decodeBody(w, r, &obj) {
+			return
+		}
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		updated, ok := s.merge(entity, id, obj)
+		if !ok {
+			writeError(w, http.StatusNotFound, fmt.Sprintf("Object not found: %s %d", entity, id))
+			return
+		}
+		writeJSON(w, http.StatusOK, map[string]any{"value": updated})
+	}
+}
+
+// updateList updates all objects or none, like Tripletex does.
+func (s *Server) updateList(entity string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var objs []map[string]any
+		if !decodeBody(w, r, &objs) {
+			return
+		}
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		ids := make([]int64, len(objs))
+		for i, obj := range objs {
+			id, ok := obj["id"].(float64)
+			if _, exists := s.stores[entity][int64(id)]; !ok || !exists {
+				writeError(w, http.StatusUnprocessableEntity, fmt.Sprintf("Object not found: %s %v", entity, obj["id"]))
+				return
+			}
+			ids[i] = int64(id)
+		}
+		updated := make([]map[string]any, len(objs))
+		for i, obj := range objs {
+			updated[i], _ = s.merge(entity, ids[i], obj)
+		}
+		writeJSON(w, http.StatusOK, map[string]any{"values": updated})
+	}
+}
+
+func (s *Server) delete(entity string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, ok := pathId(w, r)
+		if !ok {
+			return
+		}
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		if _, ok := s.stores[entity][id]; !ok {
+			writeError(w, http.StatusNotFound, fmt.Sprintf("Object not found: %s %d", entity, id))
+			return
+		}
+		delete(s.stores[entity], id)
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// pathId parses the id path value of r, writing an error to w if invalid.
+func pathId(w http.ResponseWriter, r *http.Request) (int64, bool) {
+	id, err := strconv.ParseInt(r.PathValue("id"), 10, 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("Invalid id %q", r.PathValue("id")))
+		return 0, false
+	}
+	return id, true
+}
+
+// decodeBody decodes the body of r into v, writing an error to w if invalid.
+func decodeBody(w http.ResponseWriter, r *http.Request, v any) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("Invalid body: %v", err))
+		return false
+	}
+	return true
+}
+
+// toObject converts v to a JSON object.
+func toObject(v any) (map[string]any, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var obj map[string]any
+	if err = json.Unmarshal(b, &obj); err != nil {
+		return nil, err
+	}
+	if obj == nil {
+		return nil, fmt.Errorf("%T is not a JSON object", v)
+	}
+	return obj, nil
+}
+
+// writeError writes a Tripletex error envelope with status and message.
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, tripletex.APIError{Status: status, Message: message})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	writeRaw(w, status, b)
+}
+
+func writeRaw(w http.ResponseWriter, status int, b []byte) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	_, _ = w.Write(b)
+}
@@ -0,0 +1,99 @@
+package tripletextest
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/valuetechdev/tripletex-go"
+)
+
+func TestServerCRUD(t *testing.T) {
+	require := require.New(t)
+
+	srv := NewServer()
+	defer srv.Close()
+	c := srv.Client()
+	ctx := context.Background()
+
+	name := "Acme AS"
+	created, err := c.CustomerPostWithApplicationJSONCharsetUTF8BodyWithResponse(ctx, tripletex.Customer{Name: &name})
+	require.NoError(err)
+	require.Equal(http.StatusCreated, created.StatusCode())
+	id := *created.JSONDefault.Value.Id
+	require.Equal(int32(0), *created.JSONDefault.Value.Version)
+
+	email := "post@acme.no"
+	updated, err := c.CustomerPutWithApplicationJSONCharsetUTF8BodyWithResponse(ctx, id, tripletex.Customer{Email: &email})
+	require.NoError(err)
+	require.Equal(http.StatusOK, updated.StatusCode())
+	require.Equal(name, *updated.JSONDefault.Value.Name)
+	require.Equal(int32(1), *updated.JSONDefault.Value.Version)
+
+	srv.Seed("customer", tripletex.Customer{Name: ptr("Other AS")})
+	list, err := c.CustomerSearchWithResponse(ctx, &tripletex.CustomerSearchParams{Email: &email})
+	require.NoError(err)
+	require.Len(*list.JSONDefault.Values, 1)
+	require.Equal(id, *(*list.JSONDefault.Values)[0].Id)
+
+	list, err = c.CustomerSearchWithResponse(ctx, &tripletex.CustomerSearchParams{From: ptr(1), Count: ptr(10)})
+	require.NoError(err)
+	require.Len(*list.JSONDefault.Values, 1)
+	require.Equal("Other AS", *(*list.JSONDefault.Values)[0].Name)
+
+	deleted, err := c.CustomerDeleteWithResponse(ctx, id)
+	require.NoError(err)
+	require.Equal(http.StatusNoContent, deleted.StatusCode())
+
+	var customer tripletex.Customer
+	require.False(srv.Get("customer", id, &customer))
+	got, err := c.CustomerGetWithResponse(ctx, id, &tripletex.CustomerGetParams{})
+	require.NoError(err)
+	require.Equal(http.StatusNotFound, got.StatusCode())
+}
+
+func TestServerBulk(t *testing.T) {
+	require := require.New(t)
+
+	srv := NewServer(WithEntities("product"))
+	defer srv.Close()
+
+	result := srv.Client().Products().BulkUpsert(context.Background(), []tripletex.Product{
+		{Name: ptr("a")},
+		{Name: ptr("b")},
+	})
+	require.NoError(result.Err())
+
+	var product tripletex.Product
+	require.True(srv.Get("product", *result.Items[1].Value.Id, &product))
+	require.Equal("b", *product.Name)
+}
+
+func TestServerWhoAmI(t *testing.T) {
+	require := require.New(t)
+
+	companyId := int32(42)
+	srv := NewServer(WithWhoAmI(tripletex.LoggedInUserInfo{CompanyId: &companyId}))
+	defer srv.Close()
+
+	res, err := srv.Client().TokenSessionWhoAmIWhoAmIWithResponse(context.Background(), &tripletex.TokenSessionWhoAmIWhoAmIParams{})
+	require.NoError(err)
+	require.Equal(companyId, *res.JSONDefault.Value.CompanyId)
+}
+
+func TestServerUnauthorized(t *testing.T) {
+	require := require.New(t)
+
+	srv := NewServer()
+	defer srv.Close()
+
+	res, err := http.Get(srv.URL + "/customer")
+	require.NoError(err)
+	defer res.Body.Close()
+	require.Equal(http.StatusUnauthorized, res.StatusCode)
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
@@ -0,0 +1,291 @@
+package tripletextest
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/valuetechdev/tripletex-go"
+)
+
+// redacted replaces secrets in recorded interactions.
+const redacted = "REDACTED"
+
+// sessionCreatePath is the path suffix of session token creation.
+const sessionCreatePath = "/token/session/:create"
+
+// redactedParams are the query parameters redacted in recorded requests.
+// expirationDate isn't secret, but changes with the day of the recording.
+var redactedParams = []string{"consumerToken", "employeeToken", "expirationDate"}
+
+// Interaction is a recorded request and its response.
+type Interaction struct {
+	Method         string      `json:"method"`
+	Path           string      `json:"path"` // Path and redacted query
+	RequestBody    string      `json:"requestBody,omitempty"`
+	Status         int         `json:"status"`
+	ResponseHeader http.Header `json:"responseHeader,omitempty"`
+	ResponseBody   string      `json:"responseBody,omitempty"`
+}
+
+// Recorder is an [http.RoundTripper] recording interactions with the
+// Tripletex API, to be saved as a fixture for a [Replayer].
+//
+// Tokens are redacted: requests are recorded without headers, the token
+// query parameters of session creation are replaced, and so are the
+// session, consumer and employee tokens and the encryption key in its
+// responses.
+//
+//	rec := tripletextest.NewRecorder(nil)
+//	client := tripletex.New(creds, tripletex.WithHttpClient(&http.Client{Transport: rec}))
+//	// ...
+//	err := rec.Save("testdata/customers.json")
+type Recorder struct {
+	next http.RoundTripper
+
+	mu           sync.Mutex
+	interactions []Interaction
+}
+
+// NewRecorder returns a [Recorder] sending requests with next, or
+// [http.DefaultTransport] if nil.
+func NewRecorder(next http.RoundTripper) *Recorder {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &Recorder{next: next}
+}
+
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	var reqBody []byte
+	if req.Body != nil {
+		var err error
+		reqBody, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("tripletextest: recorder: failed to read request body: %w", err)
+		}
+		req = req.Clone(req.Context())
+		req.Body = io.NopCloser(bytes.NewReader(reqBody))
+	}
+
+	res, err := r.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	resBody, err := io.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("tripletextest: recorder: failed to read response body: %w", err)
+	}
+	res.Body = io.NopCloser(bytes.NewReader(resBody))
+
+	recorded := resBody
+	if isSessionCreate(req.URL) {
+		recorded = redactSessionToken(resBody)
+	}
+	header := res.Header.Clone()
+	header.Del("Set-Cookie")
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.interactions = append(r.interactions, Interaction{
+		Method:         req.Method,
+		Path:           redactPath(req.URL),
+		RequestBody:    string(reqBody),
+		Status:         res.StatusCode,
+		ResponseHeader: header,
+		ResponseBody:   string(recorded),
+	})
+	return res, nil
+}
+
+// Interactions returns the interactions recorded so far.
+func (r *Recorder) Interactions() []Interaction {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return slices.Clone(r.interactions)
+}
+
+// Save writes the interactions recorded so far to the fixture at path.
+func (r *Recorder) Save(path string) error {
+	b, err := json.MarshalIndent(r.Interactions(), "", "  ")
+	if err != nil {
+		return fmt.Errorf("tripletextest: recorder: failed to encode interactions: %w", err)
+	}
+	if err = os.WriteFile(path, b, 0o644); err != nil {
+		return fmt.Errorf("tripletextest: recorder: failed to write %s: %w", path, err)
+	}
+	return nil
+}
+
+// Replayer is an [http.RoundTripper] serving interactions recorded by a
+// [Recorder], without sending requests.
+//
+// Requests are matched on method, path, redacted query and body. Each
+// interaction is served once, in recorded order, so repeated requests get
+// the responses they got when recorded. Session tokens are replayed with the
+// requested expiration date, so they're valid whenever the fixture is
+// replayed.
+type Replayer struct {
+	mu           sync.Mutex
+	interactions []Interaction
+	used         []bool
+}
+
+// ErrNoInteraction is returned by a [Replayer] for requests without a
+// matching unused interaction.
+var ErrNoInteraction = errors.New("tripletextest: replayer: no recorded interaction")
+
+// NewReplayer returns a [Replayer] serving interactions.
+func NewReplayer(interactions []Interaction) *Replayer {
+	return &Replayer{interactions: interactions, used: make([]bool, len(interactions))}
+}
+
+// LoadReplayer returns a [Replayer] serving the interactions in the fixture at
+// path.
+func LoadReplayer(path string) (*Replayer, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("tripletextest: replayer: failed to read %s: %w", path, err)
+	}
+	var interactions []Interaction
+	if err = json.Unmarshal(b, &interactions); err != nil {
+		return nil, fmt.Errorf("tripletextest: replayer: failed to decode %s: %w", path, err)
+	}
+	return NewReplayer(interactions), nil
+}
+
+func (r *Replayer) RoundTrip(req *http.Request) (*http.Response, error) {
+	var reqBody []byte
+	if req.Body != nil {
+		var err error
+		reqBody, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("tripletextest: replayer: failed to read request body: %w", err)
+		}
+	}
+	path := redactPath(req.URL)
+
+	r.mu.Lock()
+	var match *Interaction
+	for i, in := range r.interactions {
+		if !r.used[i] && in.Method == req.Method && in.Path == path && in.RequestBody == string(reqBody) {
+			r.used[i] = true
+			match = &r.interactions[i]
+			break
+		}
+	}
+	r.mu.Unlock()
+	if match == nil {
+		return nil, fmt.Errorf("%w for %s %s", ErrNoInteraction, req.Method, path)
+	}
+
+	body := []byte(match.ResponseBody)
+	if isSessionCreate(req.URL) {
+		body = withExpirationDate(body, req.URL.Query().Get("expirationDate"))
+	}
+	header := match.ResponseHeader.Clone()
+	if header == nil {
+		header = make(http.Header)
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", match.Status, http.StatusText(match.Status)),
+		StatusCode:    match.Status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
+
+// Unused returns the interactions not served yet, eg. to check that a test
+// made all the recorded requests.
+func (r *Replayer) Unused() []Interaction {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var unused []Interaction
+	for i, in := range r.interactions {
+		if !r.used[i] {
+			unused = append(unused, in)
+		}
+	}
+	return unused
+}
+
+// isSessionCreate reports whether u is the session token creation endpoint.
+func isSessionCreate(u *url.URL) bool {
+	return strings.HasSuffix(u.Path, sessionCreatePath)
+}
+
+// redactPath returns the path and query of u, with [redactedParams]
+// replaced.
+func redactPath(u *url.URL) string {
+	q := u.Query()
+	for _, param := range redactedParams {
+		if q.Has(param) {
+			q.Set(param, redacted)
+		}
+	}
+	if len(q) == 0 {
+		return u.Path
+	}
+	return u.Path + "?" + q.Encode()
+}
+
+// redactSessionToken replaces the credentials in a session token response
+// body.
+func redactSessionToken(body []byte) []byte {
+	var res tripletex.ResponseWrapperSessionToken
+	if err := json.Unmarshal(body, &res); err != nil || res.Value == nil {
+		return body
+	}
+	redact := func(s *string) *string {
+		if s == nil {
+			return nil
+		}
+		r := redacted
+		return &r
+	}
+	v := res.Value
+	v.Token = redact(v.Token)
+	v.EncryptionKey = redact(v.EncryptionKey)
+	if v.ConsumerToken != nil {
+		v.ConsumerToken.Token = redact(v.ConsumerToken.Token)
+	}
+	if v.EmployeeToken != nil {
+		v.EmployeeToken.Token = redact(v.EmployeeToken.Token)
+	}
+	b, err := json.Marshal(res)
+	if err != nil {
+		return body
+	}
+	return b
+}
+
+// withExpirationDate sets the expiration date of a session token response
+// body.
+func withExpirationDate(body []byte, expirationDate string) []byte {
+	var res tripletex.ResponseWrapperSessionToken
+	if err := json.Unmarshal(body, &res); err != nil || res.Value == nil || expirationDate == "" {
+		return body
+	}
+	res.Value.ExpirationDate = &expirationDate
+	b, err := json.Marshal(res)
+	if err != nil {
+		return body
+	}
+	return b
+}
@@ -0,0 +1,72 @@
+package tripletextest
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/valuetechdev/tripletex-go"
+)
+
+func TestRecordReplay(t *testing.T) {
+	require := require.New(t)
+
+	fixture := filepath.Join(t.TempDir(), "customers.json")
+	ctx := context.Background()
+	run := func(c *tripletex.TripletexClient) string {
+		created, err := c.CustomerPostWithApplicationJSONCharsetUTF8BodyWithResponse(ctx, tripletex.Customer{Name: ptr("Acme AS")})
+		require.NoError(err)
+		got, err := c.CustomerGetWithResponse(ctx, *created.JSONDefault.Value.Id, &tripletex.CustomerGetParams{})
+		require.NoError(err)
+		return *got.JSONDefault.Value.Name
+	}
+
+	srv := NewServer()
+	rec := NewRecorder(nil)
+	recorded := run(srv.Client(tripletex.WithHttpClient(&http.Client{Transport: rec})))
+	srv.Close()
+	require.NoError(rec.Save(fixture))
+
+	b, err := os.ReadFile(fixture)
+	require.NoError(err)
+	require.NotContains(string(b), "tripletextest-token")
+	require.NotContains(string(b), "consumerToken=consumer")
+
+	replayer, err := LoadReplayer(fixture)
+	require.NoError(err)
+	client := tripletex.New(
+		tripletex.Credentials{ConsumerToken: "other", EmployeeToken: "other"},
+		tripletex.WithBaseURLOption(srv.URL),
+		tripletex.WithHttpClient(&http.Client{Transport: replayer}),
+	)
+	require.Equal(recorded, run(client))
+	require.Empty(replayer.Unused())
+
+	_, err = client.CustomerGetWithResponse(ctx, 1, &tripletex.CustomerGetParams{})
+	require.ErrorIs(err, ErrNoInteraction)
+}
+
+func TestRedactSessionToken(t *testing.T) {
+	require := require.New(t)
+
+	body, err := json.Marshal(tripletex.ResponseWrapperSessionToken{Value: &tripletex.SessionToken{
+		Token:          ptr("session-secret"),
+		EncryptionKey:  ptr("encryption-secret"),
+		ConsumerToken:  &tripletex.ConsumerToken{Token: ptr("consumer-secret")},
+		EmployeeToken:  &tripletex.EmployeeToken{Token: ptr("employee-secret")},
+		ExpirationDate: ptr("2025-03-01"),
+	}})
+	require.NoError(err)
+
+	var res tripletex.ResponseWrapperSessionToken
+	require.NoError(json.Unmarshal(redactSessionToken(body), &res))
+	require.Equal(redacted, *res.Value.Token)
+	require.Equal(redacted, *res.Value.EncryptionKey)
+	require.Equal(redacted, *res.Value.ConsumerToken.Token)
+	require.Equal(redacted, *res.Value.EmployeeToken.Token)
+	require.Equal("2025-03-01", *res.Value.ExpirationDate)
+}
@@ -0,0 +1,212 @@
+package tripletex
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// upsertOps are the entity specific operations used by [upsert].
+type upsertOps[T any] struct {
+	name   string // Used in error messages
+	find   func(ctx context.Context) ([]T, error)
+	create func(ctx context.Context, v T) (*T, error)
+	update func(ctx context.Context, existing, v T) (*T, error)
+}
+
+// upsert looks up an entity with ops.find, and creates v if none was found or
+// updates the single match with v merged into it.
+//
+// Returns true if the entity was created. Returns error if more than one entity
+// was found.
+func upsert[T any](ctx context.Context, v T, ops upsertOps[T]) (*T, bool, error) {
+	found, err := ops.find(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	switch len(found) {
+	case 0:
+		created, err := ops.create(ctx, v)
+		if err != nil {
+			return nil, false, err
+		}
+		return created, true, nil
+	case 1:
+		merged, err := mergeFields(found[0], v)
+		if err != nil {
+			return nil, false, fmt.Errorf("tripletex: upsert: failed to merge %s: %w", ops.name, err)
+		}
+		updated, err := ops.update(ctx, found[0], merged)
+		if err != nil {
+			return nil, false, err
+		}
+		return updated, false, nil
+	default:
+		return nil, false, fmt.Errorf("tripletex: upsert: found %d matching %ss, expected at most one", len(found), ops.name)
+	}
+}
+
+// mergeFields returns dst with every field set in src overlaid on it.
+//
+// Relies on the generated models using pointers with omitempty, so that only
+// fields set in src are encoded.
+func mergeFields[T any](dst, src T) (T, error) {
+	b, err := json.Marshal(src)
+	if err != nil {
+		return dst, err
+	}
+	if err = json.Unmarshal(b, &dst); err != nil {
+		return dst, err
+	}
+	return dst, nil
+}
+
+// UpsertCustomerByOrgNumber creates customer, or updates the existing customer
+// with the same organization number.
+//
+// Fields set on customer are merged into the existing customer before
+// updating. Returns true if the customer was created.
+//
+// Returns error if customer has no organization number, or if multiple
+// customers share it.
+func (c *TripletexClient) UpsertCustomerByOrgNumber(ctx context.Context, customer Customer) (*Customer, bool, error) {
+	if customer.OrganizationNumber == nil || *customer.OrganizationNumber == "" {
+		return nil, false, fmt.Errorf("tripletex: upsert: customer is missing organization number")
+	}
+	orgNumber := *customer.OrganizationNumber
+
+	return upsert(ctx, customer, upsertOps[Customer]{
+		name: "customer",
+		find: func(ctx context.Context) ([]Customer, error) {
+			res, err := c.CustomerSearchWithResponse(ctx, &CustomerSearchParams{OrganizationNumber: &orgNumber})
+			if err != nil {
+				return nil, fmt.Errorf("tripletex: upsert: failed to search customers: %w", err)
+			}
+			if err = checkResponse(res.HTTPResponse, res.Body); err != nil {
+				return nil, fmt.Errorf("tripletex: upsert: failed to search customers: %w", err)
+			}
+			if res.JSONDefault == nil {
+				return nil, nil
+			}
+			return listValues(res.JSONDefault.Values), nil
+		},
+		create: func(ctx context.Context, v Customer) (*Customer, error) {
+			res, err := c.CustomerPostWithApplicationJSONCharsetUTF8BodyWithResponse(ctx, v)
+			if err != nil {
+				return nil, fmt.Errorf("tripletex: upsert: failed to create customer: %w", err)
+			}
+			if err = checkResponse(res.HTTPResponse, res.Body); err != nil {
+				return nil, fmt.Errorf("tripletex: upsert: failed to create customer: %w", err)
+			}
+			if res.JSONDefault == nil || res.JSONDefault.Value == nil {
+				return nil, fmt.Errorf("tripletex: upsert: created customer is empty")
+			}
+			return res.JSONDefault.Value, nil
+		},
+		update: func(ctx context.Context, existing, v Customer) (*Customer, error) {
+			if existing.Id == nil {
+				return nil, fmt.Errorf("tripletex: upsert: existing customer is missing id")
+			}
+			v.Id, v.Version = existing.Id, existing.Version
+			res, err := c.CustomerPutWithApplicationJSONCharsetUTF8BodyWithResponse(ctx, *existing.Id, v)
+			if err != nil {
+				return nil, fmt.Errorf("tripletex: upsert: failed to update customer %d: %w", *existing.Id, err)
+			}
+			if err = checkResponse(res.HTTPResponse, res.Body); err != nil {
+				return nil, fmt.Errorf("tripletex: upsert: failed to update customer %d: %w", *existing.Id, err)
+			}
+			if res.JSONDefault == nil || res.JSONDefault.Value == nil {
+				return nil, fmt.Errorf("tripletex: upsert: updated customer is empty")
+			}
+			return res.JSONDefault.Value, nil
+		},
+	})
+}
+
+// UpsertSupplierByOrgNumber creates supplier, or updates the existing supplier
+// with the same organization number.
+//
+// Fields set on supplier are merged into the existing supplier before
+// updating. Returns true if the supplier was created.
+//
+// Returns error if supplier has no organization number, or if multiple
+// suppliers share it.
+func (c *TripletexClient) UpsertSupplierByOrgNumber(ctx context.Context, supplier Supplier) (*Supplier, bool, error) {
+	if supplier.OrganizationNumber == nil || *supplier.OrganizationNumber == "" {
+		return nil, false, fmt.Errorf("tripletex: upsert: supplier is missing organization number")
+	}
+	orgNumber := *supplier.OrganizationNumber
+
+	return upsert(ctx, supplier, upsertOps[Supplier]{
+		name: "supplier",
+		find: func(ctx context.Context) ([]Supplier, error) {
+			res, err := c.SupplierSearchWithResponse(ctx, &SupplierSearchParams{OrganizationNumber: &orgNumber})
+			if err != nil {
+				return nil, fmt.Errorf("tripletex: upsert: failed to search suppliers: %w", err)
+			}
+			if err = checkResponse(res.HTTPResponse, res.Body); err != nil {
+				return nil, fmt.Errorf("tripletex: upsert: failed to search suppliers: %w", err)
+			}
+			if res.JSONDefault == nil {
+				return nil, nil
+			}
+			return listValues(res.JSONDefault.Values), nil
+		},
+		create: func(ctx context.Context, v Supplier) (*Supplier, error) {
+			res, err := c.SupplierPostWithApplicationJSONCharsetUTF8BodyWithResponse(ctx, v)
+			if err != nil {
+				return nil, fmt.Errorf("tripletex: upsert: failed to create supplier: %w", err)
+			}
+			if err = checkResponse(res.HTTPResponse, res.Body); err != nil {
+				return nil, fmt.Errorf("tripletex: upsert: failed to create supplier: %w", err)
+			}
+			if res.JSONDefault == nil || res.JSONDefault.Value == nil {
+				return nil, fmt.Errorf("tripletex: upsert: created supplier is empty")
+			}
+			return res.JSONDefault.Value, nil
+		},
+		update: func(ctx context.Context, existing, v Supplier) (*Supplier, error) {
+			if existing.Id == nil {
+				return nil, fmt.Errorf("tripletex: upsert: existing supplier is missing id")
+			}
+			v.Id, v.Version = existing.Id, existing.Version
+			res, err := c.SupplierPutWithApplicationJSONCharsetUTF8BodyWithResponse(ctx, *existing.Id, v)
+			if err != nil {
+				return nil, fmt.Errorf("tripletex: upsert: failed to update supplier %d: %w", *existing.Id, err)
+			}
+			if err = checkResponse(res.HTTPResponse, res.Body); err != nil {
+				return nil, fmt.Errorf("tripletex: upsert: failed to update supplier %d: %w", *existing.Id, err)
+			}
+			if res.JSONDefault == nil || res.JSONDefault.Value == nil {
+				return nil, fmt.Errorf("tripletex: upsert: updated supplier is empty")
+			}
+			return res.JSONDefault.Value, nil
+		},
+	})
+}
+
+// createOnce looks up an entity with ops.find, and creates v if none was
+// found or returns the single match as is. ops.update isn't used.
+//
+// Returns true if the entity was created. Returns error if more than one
+// entity was found.
+func createOnce[T any](ctx context.Context, v T, ops upsertOps[T]) (*T, bool, error) {
+	found, err := ops.find(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	switch len(found) {
+	case 0:
+		created, err := ops.create(ctx, v)
+		if err != nil {
+			return nil, false, err
+		}
+		return created, true, nil
+	case 1:
+		return &found[0], false, nil
+	default:
+		return nil, false, fmt.Errorf("tripletex: create: found %d matching %ss, expected at most one", len(found), ops.name)
+	}
+}
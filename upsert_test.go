@@ -0,0 +1,82 @@
+package tripletex
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpsertCustomerByOrgNumber(t *testing.T) {
+	for _, tt := range []struct {
+		description     string
+		existing        []Customer
+		expectedCreated bool
+		expectedName    string
+		expectedEmail   string
+	}{
+		{
+			description:     "create",
+			expectedCreated: true,
+			expectedName:    "New AS",
+		},
+		{
+			description: "update merges fields",
+			existing: []Customer{
+				{Id: ptr(int64(5)), Version: ptr(int32(2)), Name: ptr("Old AS"), Email: ptr("post@old.no"), OrganizationNumber: ptr("123456789")},
+			},
+			expectedName:  "New AS",
+			expectedEmail: "post@old.no",
+		},
+	} {
+		t.Run(tt.description, func(t *testing.T) {
+			require := require.New(t)
+
+			mux := http.NewServeMux()
+			mux.HandleFunc("GET /customer", func(w http.ResponseWriter, r *http.Request) {
+				require.Equal("123456789", r.URL.Query().Get("organizationNumber"))
+				writeTestJSON(w, http.StatusOK, ListResponseCustomer{Values: &tt.existing})
+			})
+			mux.HandleFunc("POST /customer", func(w http.ResponseWriter, r *http.Request) {
+				var v Customer
+				require.NoError(json.NewDecoder(r.Body).Decode(&v))
+				v.Id = ptr(int64(9))
+				writeTestJSON(w, http.StatusCreated, ResponseWrapperCustomer{Value: &v})
+			})
+			mux.HandleFunc("PUT /customer/{id}", func(w http.ResponseWriter, r *http.Request) {
+				require.Equal("5", r.PathValue("id"))
+				var v Customer
+				require.NoError(json.NewDecoder(r.Body).Decode(&v))
+				require.Equal(int32(2), *v.Version, "version should be kept from existing customer")
+				writeTestJSON(w, http.StatusOK, ResponseWrapperCustomer{Value: &v})
+			})
+			c := newTestClient(t, mux)
+
+			customer, created, err := c.UpsertCustomerByOrgNumber(context.Background(), Customer{
+				Name:               ptr("New AS"),
+				OrganizationNumber: ptr("123456789"),
+			})
+			require.NoError(err)
+			require.Equal(tt.expectedCreated, created)
+			require.Equal(tt.expectedName, *customer.Name)
+			if tt.expectedEmail != "" {
+				require.Equal(tt.expectedEmail, *customer.Email)
+			}
+		})
+	}
+}
+
+func TestUpsertCustomerByOrgNumberAmbiguous(t *testing.T) {
+	require := require.New(t)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /customer", func(w http.ResponseWriter, r *http.Request) {
+		writeTestJSON(w, http.StatusOK, ListResponseCustomer{Values: &[]Customer{{Id: ptr(int64(1))}, {Id: ptr(int64(2))}}})
+	})
+	c := newTestClient(t, mux)
+
+	_, _, err := c.UpsertCustomerByOrgNumber(context.Background(), Customer{OrganizationNumber: ptr("123456789")})
+	require.ErrorContains(err, "found 2 matching customers")
+}
@@ -0,0 +1,90 @@
+package tripletex
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// VatTypeService resolves VAT types, whose ids differ between companies.
+//
+// Use [TripletexClient.VatTypes] to get one.
+type VatTypeService struct {
+	client *TripletexClient
+}
+
+// VatTypes returns a [VatTypeService] using c.
+func (c *TripletexClient) VatTypes() *VatTypeService {
+	return &VatTypeService{client: c}
+}
+
+// ByCode returns the VAT type with code, eg. "3" for outgoing VAT with high
+// rate, from the [RefData] cache.
+//
+// Returns [ErrNotFound] if no VAT type has code.
+func (s *VatTypeService) ByCode(ctx context.Context, code string) (*VatType, error) {
+	return s.client.RefData().VatTypeByNumber(ctx, code)
+}
+
+// ByPercentage returns the VAT types with percentage that are valid for
+// ledger postings on date, eg. 25.0 for the high rate. VAT types valid on a
+// date are cached like other [RefData].
+//
+// Several VAT types usually share a percentage, eg. incoming and outgoing VAT
+// with the same rate, so all are returned. Since rates change over time, a
+// percentage that was valid on one date may give no VAT types on another.
+//
+// Returns [ErrNotFound] if no VAT type has percentage on date.
+func (s *VatTypeService) ByPercentage(ctx context.Context, percentage float32, date time.Time) ([]VatType, error) {
+	vatTypes, err := s.client.RefData().vatTypesOn(ctx, date)
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []VatType
+	for _, v := range vatTypes {
+		if v.Percentage != nil && *v.Percentage == percentage {
+			matches = append(matches, v)
+		}
+	}
+	if len(matches) == 0 {
+		return nil, fmt.Errorf("%w: vat type with %g%% on %s", ErrNotFound, percentage, date.Format(time.DateOnly))
+	}
+	return matches, nil
+}
+
+// vatTypesOn returns the VAT types valid for ledger postings on date.
+func (r *RefData) vatTypesOn(ctx context.Context, date time.Time) ([]VatType, error) {
+	vatDate := date.Format(time.DateOnly)
+
+	r.mu.Lock()
+	if r.vatTypesByDate == nil {
+		r.vatTypesByDate = make(map[string]*refCache[VatType])
+	}
+	cache, ok := r.vatTypesByDate[vatDate]
+	if !ok {
+		cache = &refCache[VatType]{}
+		r.vatTypesByDate[vatDate] = cache
+	}
+	r.mu.Unlock()
+
+	typeOfVat := LEDGER
+	return cache.get(ctx, r.ttl, func(ctx context.Context, from, count int) ([]VatType, PageInfo, error) {
+		res, err := r.client.LedgerVatTypeSearchWithResponse(ctx, &LedgerVatTypeSearchParams{
+			TypeOfVat: &typeOfVat,
+			VatDate:   &vatDate,
+			From:      &from,
+			Count:     &count,
+		})
+		if err != nil {
+			return nil, PageInfo{}, fmt.Errorf("tripletex: refdata: failed to search vat types on %s: %w", vatDate, err)
+		}
+		if err = checkResponse(res.HTTPResponse, res.Body); err != nil {
+			return nil, PageInfo{}, fmt.Errorf("tripletex: refdata: failed to search vat types on %s: %w", vatDate, err)
+		}
+		if res.JSONDefault == nil {
+			return nil, PageInfo{}, nil
+		}
+		return listValues(res.JSONDefault.Values), res.JSONDefault.PageInfo(), nil
+	})
+}
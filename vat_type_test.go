@@ -0,0 +1,54 @@
+package tripletex
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestVatTypeByPercentage(t *testing.T) {
+	require := require.New(t)
+
+	requests := make(map[string]int)
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /ledger/vatType", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal("LEDGER", r.URL.Query().Get("typeOfVat"))
+		vatDate := r.URL.Query().Get("vatDate")
+		requests[vatDate]++
+
+		// The low food rate changed from 14% to 15% in 2016.
+		foodRate := float32(15)
+		if vatDate < "2016-01-01" {
+			foodRate = 14
+		}
+		writeTestJSON(w, http.StatusOK, ListResponseVatType{Values: &[]VatType{
+			{Id: ptr(int64(1)), Number: ptr("1"), Percentage: ptr(float32(25))},
+			{Id: ptr(int64(3)), Number: ptr("3"), Percentage: ptr(float32(25))},
+			{Id: ptr(int64(31)), Number: ptr("31"), Percentage: &foodRate},
+		}})
+	})
+	c := newTestClient(t, mux)
+	ctx := context.Background()
+	date2015 := time.Date(2015, 6, 1, 0, 0, 0, 0, time.UTC)
+	date2020 := time.Date(2020, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	vatTypes, err := c.VatTypes().ByPercentage(ctx, 25, date2020)
+	require.NoError(err)
+	require.Len(vatTypes, 2)
+
+	vatTypes, err = c.VatTypes().ByPercentage(ctx, 15, date2020)
+	require.NoError(err)
+	require.Equal("31", *vatTypes[0].Number)
+
+	_, err = c.VatTypes().ByPercentage(ctx, 15, date2015)
+	require.ErrorIs(err, ErrNotFound)
+
+	vatTypes, err = c.VatTypes().ByPercentage(ctx, 14, date2015)
+	require.NoError(err)
+	require.Equal("31", *vatTypes[0].Number)
+
+	require.Equal(map[string]int{"2015-06-01": 1, "2020-06-01": 1}, requests)
+}
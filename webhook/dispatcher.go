@@ -0,0 +1,224 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime/debug"
+	"sync"
+
+	"github.com/valuetechdev/tripletex-go"
+)
+
+// defaultConcurrency is the default number of events a [Dispatcher] handles
+// at the same time.
+const defaultConcurrency = 8
+
+// IdFunc handles an event by the id of its entity.
+type IdFunc func(ctx context.Context, id int64) error
+
+// DispatcherOption configures a [Dispatcher].
+type DispatcherOption func(*Dispatcher)
+
+// WithConcurrency sets the maximum number of events handled at the same time.
+// Defaults to 8.
+func WithConcurrency(n int) DispatcherOption {
+	return func(d *Dispatcher) {
+		if n > 0 {
+			d.sem = make(chan struct{}, n)
+		}
+	}
+}
+
+// WithDeduplication skips events already handled, as recorded in store. An
+// event is recorded once all its functions succeed.
+//
+// Events are identified by [EventId], so an event replayed by [Recovery] is
+// skipped if it was already delivered by webhook, and the other way around.
+// Events without an id are always handled.
+func WithDeduplication(store SeenStore) DispatcherOption {
+	return func(d *Dispatcher) {
+		d.seen = store
+	}
+}
+
+// Dispatcher routes events to the functions registered for them, with a
+// limit on concurrently handled events. Panics in registered functions are
+// recovered and returned as errors.
+//
+// Use [Dispatcher.Dispatch] as the [HandlerFunc] of a [Handler]:
+//
+//	d := webhook.NewDispatcher()
+//	d.OnCustomerChanged(func(ctx context.Context, id int64) error { ... })
+//	h := webhook.NewHandler(webhook.WithHMACSecret(secret))
+//	h.HandleAll(d.Dispatch)
+type Dispatcher struct {
+	sem  chan struct{}
+	seen SeenStore
+
+	mu       sync.RWMutex
+	handlers map[tripletex.EventKey][]HandlerFunc
+}
+
+// NewDispatcher returns a [Dispatcher] configured with options.
+func NewDispatcher(options ...DispatcherOption) *Dispatcher {
+	d := &Dispatcher{
+		sem:      make(chan struct{}, defaultConcurrency),
+		handlers: make(map[tripletex.EventKey][]HandlerFunc),
+	}
+	for _, option := range options {
+		option(d)
+	}
+	return d
+}
+
+// On registers fn for events with key. Several functions can be registered
+// for the same key, and are called in the order they were registered.
+func (d *Dispatcher) On(key tripletex.EventKey, fn HandlerFunc) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.handlers[key] = append(d.handlers[key], fn)
+}
+
+// handles reports whether a function is registered for key.
+func (d *Dispatcher) handles(key tripletex.EventKey) bool {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return len(d.handlers[key]) > 0
+}
+
+// onId registers fn for events with key, called with the entity id.
+func (d *Dispatcher) onId(key tripletex.EventKey, fn IdFunc) {
+	d.On(key, func(ctx context.Context, event Event) error {
+		return fn(ctx, event.Id)
+	})
+}
+
+// Dispatch calls the functions registered for the key of event, waiting for
+// a free slot if the concurrency limit is reached.
+//
+// Returns the errors of the functions joined, or ctx.Err() if ctx is done
+// while waiting. Events already seen are skipped, see [WithDeduplication].
+func (d *Dispatcher) Dispatch(ctx context.Context, event Event) error {
+	d.mu.RLock()
+	fns := d.handlers[event.Key]
+	d.mu.RUnlock()
+	if len(fns) == 0 {
+		return nil
+	}
+
+	select {
+	case d.sem <- struct{}{}:
+		defer func() { <-d.sem }()
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	var id string
+	if d.seen != nil {
+		id = EventId(event)
+	}
+	if id != "" {
+		seen, err := d.seen.Seen(ctx, id)
+		if err != nil {
+			return fmt.Errorf("webhook: failed to check if %s was seen: %w", id, err)
+		}
+		if seen {
+			return nil
+		}
+	}
+
+	var errs []error
+	for _, fn := range fns {
+		if err := safeCall(ctx, fn, event); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+
+	if id != "" {
+		if err := d.seen.MarkSeen(ctx, id); err != nil {
+			return fmt.Errorf("webhook: failed to mark %s as seen: %w", id, err)
+		}
+	}
+	return nil
+}
+
+// safeCall calls fn, returning a panic in fn as error.
+func safeCall(ctx context.Context, fn HandlerFunc, event Event) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("webhook: %s handler panicked: %v\n%s", event.Key, r, debug.Stack())
+		}
+	}()
+	return fn(ctx, event)
+}
+
+// OnCustomerCreated registers fn for [tripletex.EventCustomerCreate].
+func (d *Dispatcher) OnCustomerCreated(fn IdFunc) { d.onId(tripletex.EventCustomerCreate, fn) }
+
+// OnCustomerChanged registers fn for [tripletex.EventCustomerUpdate].
+func (d *Dispatcher) OnCustomerChanged(fn IdFunc) { d.onId(tripletex.EventCustomerUpdate, fn) }
+
+// OnCustomerDeleted registers fn for [tripletex.EventCustomerDelete].
+func (d *Dispatcher) OnCustomerDeleted(fn IdFunc) { d.onId(tripletex.EventCustomerDelete, fn) }
+
+// OnSupplierCreated registers fn for [tripletex.EventSupplierCreate].
+func (d *Dispatcher) OnSupplierCreated(fn IdFunc) { d.onId(tripletex.EventSupplierCreate, fn) }
+
+// OnSupplierChanged registers fn for [tripletex.EventSupplierUpdate].
+func (d *Dispatcher) OnSupplierChanged(fn IdFunc) { d.onId(tripletex.EventSupplierUpdate, fn) }
+
+// OnSupplierDeleted registers fn for [tripletex.EventSupplierDelete].
+func (d *Dispatcher) OnSupplierDeleted(fn IdFunc) { d.onId(tripletex.EventSupplierDelete, fn) }
+
+// OnContactCreated registers fn for [tripletex.EventContactCreate].
+func (d *Dispatcher) OnContactCreated(fn IdFunc) { d.onId(tripletex.EventContactCreate, fn) }
+
+// OnContactChanged registers fn for [tripletex.EventContactUpdate].
+func (d *Dispatcher) OnContactChanged(fn IdFunc) { d.onId(tripletex.EventContactUpdate, fn) }
+
+// OnContactDeleted registers fn for [tripletex.EventContactDelete].
+func (d *Dispatcher) OnContactDeleted(fn IdFunc) { d.onId(tripletex.EventContactDelete, fn) }
+
+// OnProductCreated registers fn for [tripletex.EventProductCreate].
+func (d *Dispatcher) OnProductCreated(fn IdFunc) { d.onId(tripletex.EventProductCreate, fn) }
+
+// OnProductChanged registers fn for [tripletex.EventProductUpdate].
+func (d *Dispatcher) OnProductChanged(fn IdFunc) { d.onId(tripletex.EventProductUpdate, fn) }
+
+// OnProductDeleted registers fn for [tripletex.EventProductDelete].
+func (d *Dispatcher) OnProductDeleted(fn IdFunc) { d.onId(tripletex.EventProductDelete, fn) }
+
+// OnOrderCreated registers fn for [tripletex.EventOrderCreate].
+func (d *Dispatcher) OnOrderCreated(fn IdFunc) { d.onId(tripletex.EventOrderCreate, fn) }
+
+// OnOrderChanged registers fn for [tripletex.EventOrderUpdate].
+func (d *Dispatcher) OnOrderChanged(fn IdFunc) { d.onId(tripletex.EventOrderUpdate, fn) }
+
+// OnOrderDeleted registers fn for [tripletex.EventOrderDelete].
+func (d *Dispatcher) OnOrderDeleted(fn IdFunc) { d.onId(tripletex.EventOrderDelete, fn) }
+
+// OnProjectCreated registers fn for [tripletex.EventProjectCreate].
+func (d *Dispatcher) OnProjectCreated(fn IdFunc) { d.onId(tripletex.EventProjectCreate, fn) }
+
+// OnProjectChanged registers fn for [tripletex.EventProjectUpdate].
+func (d *Dispatcher) OnProjectChanged(fn IdFunc) { d.onId(tripletex.EventProjectUpdate, fn) }
+
+// OnProjectDeleted registers fn for [tripletex.EventProjectDelete].
+func (d *Dispatcher) OnProjectDeleted(fn IdFunc) { d.onId(tripletex.EventProjectDelete, fn) }
+
+// OnEmployeeCreated registers fn for [tripletex.EventEmployeeCreate].
+func (d *Dispatcher) OnEmployeeCreated(fn IdFunc) { d.onId(tripletex.EventEmployeeCreate, fn) }
+
+// OnEmployeeChanged registers fn for [tripletex.EventEmployeeUpdate].
+func (d *Dispatcher) OnEmployeeChanged(fn IdFunc) { d.onId(tripletex.EventEmployeeUpdate, fn) }
+
+// OnEmployeeDeleted registers fn for [tripletex.EventEmployeeDelete].
+func (d *Dispatcher) OnEmployeeDeleted(fn IdFunc) { d.onId(tripletex.EventEmployeeDelete, fn) }
+
+// OnInvoiceCreated registers fn for [tripletex.EventInvoiceCharged], which is
+// sent when an invoice is created.
+func (d *Dispatcher) OnInvoiceCreated(fn IdFunc) { d.onId(tripletex.EventInvoiceCharged, fn) }
@@ -0,0 +1,97 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/valuetechdev/tripletex-go"
+)
+
+func TestDispatcher(t *testing.T) {
+	require := require.New(t)
+
+	d := NewDispatcher()
+	var changed []int64
+	d.OnCustomerChanged(func(ctx context.Context, id int64) error {
+		changed = append(changed, id)
+		return nil
+	})
+	d.OnCustomerChanged(func(ctx context.Context, id int64) error {
+		return errors.New("boom")
+	})
+	d.OnInvoiceCreated(func(ctx context.Context, id int64) error {
+		panic("oops")
+	})
+	ctx := context.Background()
+
+	err := d.Dispatch(ctx, Event{Key: tripletex.EventCustomerUpdate, Id: 42})
+	require.ErrorContains(err, "boom")
+	require.Equal([]int64{42}, changed)
+
+	err = d.Dispatch(ctx, Event{Key: tripletex.EventInvoiceCharged, Id: 1})
+	require.ErrorContains(err, "invoice.charged handler panicked: oops")
+
+	require.NoError(d.Dispatch(ctx, Event{Key: tripletex.EventProductDelete, Id: 1}))
+}
+
+func TestDispatcherConcurrency(t *testing.T) {
+	require := require.New(t)
+
+	d := NewDispatcher(WithConcurrency(2))
+	var running, maxRunning atomic.Int32
+	d.OnOrderCreated(func(ctx context.Context, id int64) error {
+		n := running.Add(1)
+		defer running.Add(-1)
+		for {
+			m := maxRunning.Load()
+			if n <= m || maxRunning.CompareAndSwap(m, n) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		return nil
+	})
+
+	var wg sync.WaitGroup
+	for i := range 6 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			require.NoError(d.Dispatch(context.Background(), Event{Key: tripletex.EventOrderCreate, Id: int64(i)}))
+		}()
+	}
+	wg.Wait()
+	require.Equal(int32(2), maxRunning.Load())
+}
+
+func TestDispatcherDeduplication(t *testing.T) {
+	require := require.New(t)
+
+	d := NewDispatcher(WithDeduplication(NewMemorySeenStore()))
+	var changed int
+	d.OnCustomerChanged(func(ctx context.Context, id int64) error {
+		changed++
+		return nil
+	})
+	ctx := context.Background()
+
+	versioned := Event{Key: tripletex.EventCustomerUpdate, Entity: "customer", Id: 1, Value: []byte(`{"id":1,"version":2}`)}
+	require.NoError(d.Dispatch(ctx, versioned))
+	require.NoError(d.Dispatch(ctx, versioned))
+	require.Equal(1, changed, "same version is skipped")
+
+	redelivered := Event{Key: tripletex.EventCustomerUpdate, Entity: "customer", Id: 1, IdempotencyKey: "k1"}
+	require.NoError(d.Dispatch(ctx, redelivered))
+	require.NoError(d.Dispatch(ctx, redelivered))
+	require.Equal(2, changed, "same notification is skipped")
+
+	unidentified := Event{Key: tripletex.EventCustomerUpdate, Entity: "customer", Id: 1}
+	require.NoError(d.Dispatch(ctx, unidentified))
+	require.NoError(d.Dispatch(ctx, unidentified))
+	require.Equal(4, changed, "updates without version or idempotency key are always handled")
+}
@@ -0,0 +1,156 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/valuetechdev/tripletex-go"
+	"github.com/valuetechdev/tripletex-go/paging"
+)
+
+// recoveryPageSize is the number of values requested per page when replaying
+// changes.
+const recoveryPageSize = 1000
+
+// SeenStore records the ids of handled events, see [WithDeduplication].
+type SeenStore interface {
+	// Seen reports whether the event with id was handled.
+	Seen(ctx context.Context, id string) (bool, error)
+	// MarkSeen records the event with id as handled.
+	MarkSeen(ctx context.Context, id string) error
+}
+
+// MemorySeenStore is a [SeenStore] keeping ids in memory. It's safe for
+// concurrent use.
+type MemorySeenStore struct {
+	mu  sync.Mutex
+	ids map[string]struct{}
+}
+
+// NewMemorySeenStore returns an empty [MemorySeenStore].
+func NewMemorySeenStore() *MemorySeenStore {
+	return &MemorySeenStore{ids: make(map[string]struct{})}
+}
+
+func (s *MemorySeenStore) Seen(ctx context.Context, id string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, ok := s.ids[id]
+	return ok, nil
+}
+
+func (s *MemorySeenStore) MarkSeen(ctx context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ids[id] = struct{}{}
+	return nil
+}
+
+// EventId returns an id identifying the change delivered by event, in the
+// form "entity/id/version".
+//
+// The version is read from the value of event, so subscriptions must include
+// version in their fields for replays by [Recovery] to be recognized.
+// Without a version the id is "idempotencyKey/<key>", which only identifies
+// redeliveries of the same notification, or "" if event has no idempotency
+// key either.
+func EventId(event Event) string {
+	var v struct {
+		Version *int32 `json:"version"`
+	}
+	if len(event.Value) > 0 && json.Unmarshal(event.Value, &v) == nil && v.Version != nil {
+		return fmt.Sprintf("%s/%d/%d", event.Entity, event.Id, *v.Version)
+	}
+	if event.IdempotencyKey != "" {
+		return "idempotencyKey/" + event.IdempotencyKey
+	}
+	return ""
+}
+
+// Recovery replays changes that may have been missed by webhooks, eg. while
+// the receiver was down or a subscription was disabled, through a
+// [Dispatcher].
+//
+// Changes are found with changedSince searches, which only customers and
+// suppliers support. They are replayed as update events, since created and
+// updated entities can't be told apart, and deletions can't be recovered.
+//
+// Use [WithDeduplication] on the dispatcher to skip changes already delivered
+// by webhook.
+type Recovery struct {
+	dispatcher *Dispatcher
+	replayers  []replayer
+}
+
+// replayer replays the changes of a single entity.
+type replayer struct {
+	entity string
+	run    func(ctx context.Context, since time.Time, emit func(id int64, value any) error) error
+}
+
+// NewRecovery returns a [Recovery] fetching changes with client and
+// dispatching them to d.
+func NewRecovery(client *tripletex.TripletexClient, d *Dispatcher) *Recovery {
+	return &Recovery{
+		dispatcher: d,
+		replayers: []replayer{
+			newReplayer(client, tripletex.CustomerSyncResource()),
+			newReplayer(client, tripletex.SupplierSyncResource()),
+		},
+	}
+}
+
+// newReplayer returns a replayer fetching the changes of resource.
+func newReplayer[T any](client *tripletex.TripletexClient, resource tripletex.SyncResource[T]) replayer {
+	return replayer{
+		entity: resource.Name,
+		run: func(ctx context.Context, since time.Time, emit func(id int64, value any) error) error {
+			fetch := func(ctx context.Context, from, count int) ([]T, paging.PageInfo, error) {
+				return resource.Fetch(ctx, client, tripletex.SyncPage{Since: since, From: from, Count: count})
+			}
+			return paging.ForEach(ctx, recoveryPageSize, fetch, func(values []T) error {
+				for _, v := range values {
+					if err := emit(resource.Id(v), v); err != nil {
+						return err
+					}
+				}
+				return nil
+			})
+		},
+	}
+}
+
+// Replay dispatches an update event for each entity changed since since, for
+// the entities with an update function registered on the dispatcher.
+//
+// Pass the time of the last event handled, minus a margin for clock skew.
+// Returns on the first error.
+func (r *Recovery) Replay(ctx context.Context, since time.Time) error {
+	for _, rp := range r.replayers {
+		update := tripletex.EventKey(rp.entity + ".update")
+		if !r.dispatcher.handles(update) {
+			continue
+		}
+
+		err := rp.run(ctx, since, func(id int64, value any) error {
+			raw, err := json.Marshal(value)
+			if err != nil {
+				return fmt.Errorf("webhook: failed to encode %s %d: %w", rp.entity, id, err)
+			}
+			return r.dispatcher.Dispatch(ctx, Event{
+				Key:    update,
+				Entity: rp.entity,
+				Action: "update",
+				Id:     id,
+				Value:  raw,
+			})
+		})
+		if err != nil {
+			return fmt.Errorf("webhook: failed to replay %s changes: %w", rp.entity, err)
+		}
+	}
+	return nil
+}
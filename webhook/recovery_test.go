@@ -0,0 +1,86 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/valuetechdev/tripletex-go"
+)
+
+func TestEventId(t *testing.T) {
+	tests := []struct {
+		description string
+		event       Event
+		want        string
+	}{
+		{"with version", Event{Entity: "customer", Id: 1, Value: json.RawMessage(`{"id":1,"version":3}`)}, "customer/1/3"},
+		{"without version", Event{Entity: "customer", Id: 1, IdempotencyKey: "k1", Value: json.RawMessage(`{"id":1}`)}, "idempotencyKey/k1"},
+		{"without value", Event{Entity: "customer", Id: 1, IdempotencyKey: "k1"}, "idempotencyKey/k1"},
+		{"without version or idempotency key", Event{Entity: "customer", Id: 1, Value: json.RawMessage(`{"id":1}`)}, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			require.Equal(t, tt.want, EventId(tt.event))
+		})
+	}
+}
+
+func TestRecoveryReplay(t *testing.T) {
+	require := require.New(t)
+
+	since := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	mux := http.NewServeMux()
+	mux.HandleFunc("PUT /token/session/:create", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, tripletex.ResponseWrapperSessionToken{Value: &tripletex.SessionToken{
+			Token:          ptr("test-token"),
+			ExpirationDate: ptr(time.Now().AddDate(0, 0, 2).Format(time.DateOnly)),
+		}})
+	})
+	mux.HandleFunc("GET /customer", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal("2025-03-01T13:00:00", r.URL.Query().Get("changedSince"))
+		writeJSON(w, tripletex.ListResponseCustomer{Values: &[]tripletex.Customer{
+			{Id: ptr[int64](1), Version: ptr[int32](2)},
+			{Id: ptr[int64](2), Version: ptr[int32](5)},
+		}})
+	})
+	mux.HandleFunc("GET /supplier", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("suppliers should not be replayed without a handler")
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	client := tripletex.New(
+		tripletex.Credentials{ConsumerToken: "consumer", EmployeeToken: "employee"},
+		tripletex.WithBaseURLOption(server.URL),
+	)
+
+	seen := NewMemorySeenStore()
+	require.NoError(seen.MarkSeen(context.Background(), "customer/1/2"))
+	d := NewDispatcher(WithDeduplication(seen))
+	var replayed []int64
+	d.OnCustomerChanged(func(ctx context.Context, id int64) error {
+		replayed = append(replayed, id)
+		return nil
+	})
+
+	err := NewRecovery(client, d).Replay(context.Background(), since)
+	require.NoError(err)
+	require.Equal([]int64{2}, replayed)
+
+	ok, err := seen.Seen(context.Background(), "customer/2/5")
+	require.NoError(err)
+	require.True(ok)
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
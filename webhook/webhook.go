@@ -0,0 +1,243 @@
+// Package webhook receives Tripletex event notifications (webhooks).
+//
+// Create a [Handler], register functions for the events subscribed to with
+// [tripletex.SubscriptionService], and serve it over HTTPS at the target URL
+// of the subscriptions.
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha512"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/valuetechdev/tripletex-go"
+)
+
+const (
+	// signatureHeader holds the HMAC signature of signed notifications.
+	signatureHeader = "x-request-signature"
+	// idempotencyKeyHeader holds a key unique to each notification.
+	idempotencyKeyHeader = "idempotency-key"
+
+	// maxBodySize is the maximum size of a notification body.
+	maxBodySize = 10 << 20
+)
+
+// Event is a decoded event notification.
+type Event struct {
+	SubscriptionId int64
+	Key            tripletex.EventKey // Eg. "customer.create"
+	Entity         string             // Eg. "customer"
+	Action         string             // Eg. "create"
+	Id             int64              // Id of the entity
+	IdempotencyKey string             // Unique to the notification, empty if not sent
+	Value          json.RawMessage    // The entity, with the subscription's fields. Empty on delete
+}
+
+// Decode decodes the value of e into v, eg. a [tripletex.Customer].
+func (e Event) Decode(v any) error {
+	if len(e.Value) == 0 || string(e.Value) == "null" {
+		return fmt.Errorf("webhook: %s event has no value", e.Key)
+	}
+	if err := json.Unmarshal(e.Value, v); err != nil {
+		return fmt.Errorf("webhook: failed to decode %s value: %w", e.Key, err)
+	}
+	return nil
+}
+
+// payload is the body of a notification.
+type payload struct {
+	SubscriptionId int64           `json:"subscriptionId"`
+	Event          string          `json:"event"`
+	Id             int64           `json:"id"`
+	Value          json.RawMessage `json:"value"`
+}
+
+// parseEvent decodes a notification body.
+func parseEvent(body []byte, idempotencyKey string) (Event, error) {
+	var p payload
+	if err := json.Unmarshal(body, &p); err != nil {
+		return Event{}, fmt.Errorf("webhook: failed to decode payload: %w", err)
+	}
+	if p.Event == "" {
+		return Event{}, errors.New("webhook: payload has no event")
+	}
+
+	entity, action, _ := strings.Cut(p.Event, ".")
+	return Event{
+		SubscriptionId: p.SubscriptionId,
+		Key:            tripletex.EventKey(p.Event),
+		Entity:         entity,
+		Action:         action,
+		Id:             p.Id,
+		IdempotencyKey: idempotencyKey,
+		Value:          p.Value,
+	}, nil
+}
+
+// HandlerFunc handles an event. Returning error makes Tripletex deliver the
+// event again later.
+type HandlerFunc func(ctx context.Context, event Event) error
+
+// Option configures a [Handler].
+type Option func(*Handler)
+
+// WithAuthHeader requires notifications to have header name with value, as
+// set with AuthHeaderName and AuthHeaderValue on the subscription.
+func WithAuthHeader(name, value string) Option {
+	return func(h *Handler) {
+		h.authHeaderName = name
+		h.authHeaderValue = value
+	}
+}
+
+// WithHMACSecret requires notifications to be signed with secret, as set with
+// HmacSharedSecret on the subscription.
+func WithHMACSecret(secret string) Option {
+	return func(h *Handler) {
+		h.hmacSecret = []byte(secret)
+	}
+}
+
+// WithoutVerification accepts notifications without an auth header or HMAC
+// signature. Only use it when notifications are verified elsewhere, eg. by
+// a proxy, or in tests.
+func WithoutVerification() Option {
+	return func(h *Handler) {
+		h.insecure = true
+	}
+}
+
+// Handler is an [http.Handler] validating and decoding Tripletex event
+// notifications, and passing them to the functions registered for their
+// events.
+//
+// Notifications are rejected unless the handler is configured with
+// [WithAuthHeader], [WithHMACSecret] or [WithoutVerification].
+//
+// Responds with 401 Unauthorized if validation fails, 400 Bad Request if the
+// payload can't be decoded, and 500 Internal Server Error if the registered
+// function returns error. Events without a registered function are
+// acknowledged and dropped.
+type Handler struct {
+	authHeaderName  string
+	authHeaderValue string
+	hmacSecret      []byte
+	insecure        bool
+
+	mu       sync.RWMutex
+	handlers map[tripletex.EventKey]HandlerFunc
+	fallback HandlerFunc
+}
+
+// NewHandler returns a [Handler] configured with options.
+func NewHandler(options ...Option) *Handler {
+	h := &Handler{handlers: make(map[tripletex.EventKey]HandlerFunc)}
+	for _, option := range options {
+		option(h)
+	}
+	return h
+}
+
+// Handle registers fn for events with key, replacing any function already
+// registered for key.
+func (h *Handler) Handle(key tripletex.EventKey, fn HandlerFunc) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.handlers[key] = fn
+}
+
+// HandleAll registers fn for events without a function registered with
+// [Handler.Handle].
+func (h *Handler) HandleAll(fn HandlerFunc) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.fallback = fn
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxBodySize))
+	if err != nil {
+		http.Error(w, "failed to read body", http.StatusBadRequest)
+		return
+	}
+
+	idempotencyKey := r.Header.Get(idempotencyKeyHeader)
+	if !h.authenticate(r.Header, idempotencyKey, body) {
+		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		return
+	}
+
+	event, err := parseEvent(body, idempotencyKey)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	fn := h.handler(event.Key)
+	if fn != nil {
+		if err = fn(r.Context(), event); err != nil {
+			http.Error(w, "failed to handle event", http.StatusInternalServerError)
+			return
+		}
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// handler returns the function registered for key, or nil.
+func (h *Handler) handler(key tripletex.EventKey) HandlerFunc {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	if fn, ok := h.handlers[key]; ok {
+		return fn
+	}
+	return h.fallback
+}
+
+// authenticate checks the auth header and HMAC signature of a notification,
+// if configured. Fails if neither is configured, unless verification is
+// disabled.
+func (h *Handler) authenticate(header http.Header, idempotencyKey string, body []byte) bool {
+	if h.authHeaderName == "" && len(h.hmacSecret) == 0 {
+		return h.insecure
+	}
+	if h.authHeaderName != "" {
+		value := header.Get(h.authHeaderName)
+		if subtle.ConstantTimeCompare([]byte(value), []byte(h.authHeaderValue)) != 1 {
+			return false
+		}
+	}
+	if len(h.hmacSecret) > 0 {
+		signature, err := base64.StdEncoding.DecodeString(header.Get(signatureHeader))
+		if err != nil || !hmac.Equal(signature, Sign(h.hmacSecret, idempotencyKey, body)) {
+			return false
+		}
+	}
+	return true
+}
+
+// Sign returns the HMAC-SHA512 signature of a notification with
+// idempotencyKey and body. Tripletex sends it base64 encoded in the
+// x-request-signature header.
+func Sign(secret []byte, idempotencyKey string, body []byte) []byte {
+	mac := hmac.New(sha512.New, secret)
+	mac.Write([]byte(idempotencyKey))
+	mac.Write([]byte("&"))
+	mac.Write(body)
+	return mac.Sum(nil)
+}
@@ -0,0 +1,126 @@
+package webhook
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/valuetechdev/tripletex-go"
+)
+
+func TestHandler(t *testing.T) {
+	secret := []byte("shared")
+	body := `{"subscriptionId":5,"event":"customer.update","id":42,"value":{"id":42,"name":"Acme"}}`
+	signature := base64.StdEncoding.EncodeToString(Sign(secret, "key-1", []byte(body)))
+
+	tests := []struct {
+		description    string
+		body           string
+		header         map[string]string
+		handlerErr     error
+		expectedStatus int
+		expectedCalls  int
+	}{
+		{
+			description:    "valid",
+			body:           body,
+			header:         map[string]string{"Authorization": "token", "idempotency-key": "key-1", "x-request-signature": signature},
+			expectedStatus: http.StatusOK,
+			expectedCalls:  1,
+		},
+		{
+			description:    "wrong auth header",
+			body:           body,
+			header:         map[string]string{"Authorization": "wrong", "idempotency-key": "key-1", "x-request-signature": signature},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			description:    "wrong signature",
+			body:           body,
+			header:         map[string]string{"Authorization": "token", "idempotency-key": "key-2", "x-request-signature": signature},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			description:    "handler fails",
+			body:           body,
+			header:         map[string]string{"Authorization": "token", "idempotency-key": "key-1", "x-request-signature": signature},
+			handlerErr:     errors.New("boom"),
+			expectedStatus: http.StatusInternalServerError,
+			expectedCalls:  1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			require := require.New(t)
+
+			calls := 0
+			h := NewHandler(WithAuthHeader("Authorization", "token"), WithHMACSecret(string(secret)))
+			h.Handle(tripletex.EventCustomerUpdate, func(ctx context.Context, event Event) error {
+				calls++
+				require.Equal(int64(5), event.SubscriptionId)
+				require.Equal("customer", event.Entity)
+				require.Equal("update", event.Action)
+				require.Equal(int64(42), event.Id)
+				require.Equal("key-1", event.IdempotencyKey)
+				var customer tripletex.Customer
+				require.NoError(event.Decode(&customer))
+				require.Equal("Acme", *customer.Name)
+				return test.handlerErr
+			})
+
+			r := httptest.NewRequest(http.MethodPost, "/hook", strings.NewReader(test.body))
+			for k, v := range test.header {
+				r.Header.Set(k, v)
+			}
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, r)
+			require.Equal(test.expectedStatus, w.Code)
+			require.Equal(test.expectedCalls, calls)
+		})
+	}
+}
+
+func TestHandlerWithoutAuth(t *testing.T) {
+	require := require.New(t)
+
+	body := `{"event":"customer.create","id":1}`
+	for _, tc := range []struct {
+		description string
+		options     []Option
+		status      int
+	}{
+		{description: "no options", status: http.StatusUnauthorized},
+		{description: "empty auth header name", options: []Option{WithAuthHeader("", "value")}, status: http.StatusUnauthorized},
+		{description: "empty HMAC secret", options: []Option{WithHMACSecret("")}, status: http.StatusUnauthorized},
+		{description: "without verification", options: []Option{WithoutVerification()}, status: http.StatusOK},
+	} {
+		t.Run(tc.description, func(t *testing.T) {
+			h := NewHandler(tc.options...)
+			r := httptest.NewRequest(http.MethodPost, "/hook", strings.NewReader(body))
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, r)
+			require.Equal(tc.status, w.Code)
+		})
+	}
+}
+
+func TestHandlerUnregisteredEvent(t *testing.T) {
+	require := require.New(t)
+
+	h := NewHandler(WithoutVerification())
+	r := httptest.NewRequest(http.MethodPost, "/hook", strings.NewReader(`{"event":"product.delete","id":1}`))
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	require.Equal(http.StatusOK, w.Code)
+
+	r = httptest.NewRequest(http.MethodPost, "/hook", strings.NewReader(`not json`))
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	require.Equal(http.StatusBadRequest, w.Code)
+}
@@ -0,0 +1,58 @@
+package tripletex
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// WhoAmI identifies the employee and company of the session token.
+type WhoAmI struct {
+	EmployeeId       int64
+	ActualEmployeeId int64 // Differs from EmployeeId when logged in as a proxy
+	CompanyId        int64
+}
+
+// whoAmICache caches the [WhoAmI] of a client.
+type whoAmICache struct {
+	mu     sync.Mutex
+	whoAmI *WhoAmI
+}
+
+// WhoAmI returns the employee and company of the session token.
+//
+// It's fetched on first use and cached for the lifetime of c, as it's given
+// by the credentials. Failed lookups aren't cached.
+func (c *TripletexClient) WhoAmI(ctx context.Context) (WhoAmI, error) {
+	c.whoAmI.mu.Lock()
+	defer c.whoAmI.mu.Unlock()
+	if c.whoAmI.whoAmI != nil {
+		return *c.whoAmI.whoAmI, nil
+	}
+
+	res, err := c.TokenSessionWhoAmIWhoAmIWithResponse(ctx, &TokenSessionWhoAmIWhoAmIParams{})
+	if err != nil {
+		return WhoAmI{}, fmt.Errorf("tripletex: whoAmI: failed to get: %w", err)
+	}
+	if err = checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return WhoAmI{}, fmt.Errorf("tripletex: whoAmI: failed to get: %w", err)
+	}
+	if res.JSONDefault == nil || res.JSONDefault.Value == nil {
+		return WhoAmI{}, fmt.Errorf("tripletex: whoAmI: value is empty")
+	}
+	info := res.JSONDefault.Value
+
+	var whoAmI WhoAmI
+	if info.EmployeeId != nil {
+		whoAmI.EmployeeId = int64(*info.EmployeeId)
+	}
+	whoAmI.ActualEmployeeId = whoAmI.EmployeeId
+	if info.ActualEmployeeId != nil {
+		whoAmI.ActualEmployeeId = *info.ActualEmployeeId
+	}
+	if info.CompanyId != nil {
+		whoAmI.CompanyId = int64(*info.CompanyId)
+	}
+	c.whoAmI.whoAmI = &whoAmI
+	return whoAmI, nil
+}
@@ -0,0 +1,39 @@
+package tripletex
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWhoAmI(t *testing.T) {
+	require := require.New(t)
+
+	calls := 0
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /token/session/>whoAmI", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			writeTestJSON(w, http.StatusInternalServerError, APIError{Status: http.StatusInternalServerError})
+			return
+		}
+		writeTestJSON(w, http.StatusOK, ResponseWrapperLoggedInUserInfo{Value: &LoggedInUserInfo{
+			EmployeeId:       ptr(int32(7)),
+			ActualEmployeeId: ptr(int64(3)),
+			CompanyId:        ptr(int32(42)),
+		}})
+	})
+	c := newTestClient(t, mux)
+
+	_, err := c.WhoAmI(context.Background())
+	require.Error(err, "should fail on error status")
+
+	for range 2 {
+		whoAmI, err := c.WhoAmI(context.Background())
+		require.NoError(err)
+		require.Equal(WhoAmI{EmployeeId: 7, ActualEmployeeId: 3, CompanyId: 42}, whoAmI)
+	}
+	require.Equal(2, calls, "should cache successful lookups")
+}